@@ -1,6 +1,7 @@
 package decimalbench
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/cockroachdb/apd/v3"
@@ -12,6 +13,7 @@ import (
 func BenchmarkAddition_Float64(b *testing.B) {
 	price := 99.99
 	tax := 8.50
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = price + tax
@@ -21,6 +23,7 @@ func BenchmarkAddition_Float64(b *testing.B) {
 func BenchmarkAddition_Shopspring(b *testing.B) {
 	price := shopspring.NewFromFloat(99.99)
 	tax := shopspring.NewFromFloat(8.50)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = price.Add(tax)
@@ -30,6 +33,7 @@ func BenchmarkAddition_Shopspring(b *testing.B) {
 func BenchmarkAddition_Govalues(b *testing.B) {
 	price, _ := govalues.Parse("99.99")
 	tax, _ := govalues.Parse("8.50")
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = price.Add(tax)
@@ -41,6 +45,7 @@ func BenchmarkAddition_Cockroach(b *testing.B) {
 	tax, _, _ := apd.NewFromString("8.50")
 	result := apd.New(0, 0)
 	ctx := apd.BaseContext
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = ctx.Add(result, price, tax)
@@ -51,6 +56,7 @@ func BenchmarkAddition_Cockroach(b *testing.B) {
 func BenchmarkMultiply_Float64(b *testing.B) {
 	price := 19.99
 	quantity := 1000.0
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = price * quantity
@@ -60,6 +66,7 @@ func BenchmarkMultiply_Float64(b *testing.B) {
 func BenchmarkMultiply_Shopspring(b *testing.B) {
 	price := shopspring.NewFromFloat(19.99)
 	quantity := shopspring.NewFromInt(1000)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = price.Mul(quantity)
@@ -69,6 +76,7 @@ func BenchmarkMultiply_Shopspring(b *testing.B) {
 func BenchmarkMultiply_Govalues(b *testing.B) {
 	price, _ := govalues.Parse("19.99")
 	quantity, _ := govalues.Parse("1000")
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = price.Mul(quantity)
@@ -80,6 +88,7 @@ func BenchmarkMultiply_Cockroach(b *testing.B) {
 	quantity, _, _ := apd.NewFromString("1000")
 	result := apd.New(0, 0)
 	ctx := apd.BaseContext
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = ctx.Mul(result, price, quantity)
@@ -90,6 +99,7 @@ func BenchmarkMultiply_Cockroach(b *testing.B) {
 func BenchmarkDivide_Float64(b *testing.B) {
 	total := 12345.67
 	count := 123.0
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = total / count
@@ -99,6 +109,7 @@ func BenchmarkDivide_Float64(b *testing.B) {
 func BenchmarkDivide_Shopspring(b *testing.B) {
 	total := shopspring.NewFromFloat(12345.67)
 	count := shopspring.NewFromInt(123)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = total.Div(count)
@@ -108,6 +119,7 @@ func BenchmarkDivide_Shopspring(b *testing.B) {
 func BenchmarkDivide_Govalues(b *testing.B) {
 	total, _ := govalues.Parse("12345.67")
 	count, _ := govalues.Parse("123")
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = total.Quo(count)
@@ -119,6 +131,7 @@ func BenchmarkDivide_Cockroach(b *testing.B) {
 	count, _, _ := apd.NewFromString("123")
 	result := apd.New(0, 0)
 	ctx := apd.BaseContext
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = ctx.Quo(result, total, count)
@@ -127,6 +140,7 @@ func BenchmarkDivide_Cockroach(b *testing.B) {
 
 // Benchmark parsing from string
 func BenchmarkParse_Float64(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_ = 123.45
@@ -134,6 +148,7 @@ func BenchmarkParse_Float64(b *testing.B) {
 }
 
 func BenchmarkParse_Shopspring(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = shopspring.NewFromString("123.45")
@@ -141,6 +156,7 @@ func BenchmarkParse_Shopspring(b *testing.B) {
 }
 
 func BenchmarkParse_Govalues(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _ = govalues.Parse("123.45")
@@ -148,6 +164,7 @@ func BenchmarkParse_Govalues(b *testing.B) {
 }
 
 func BenchmarkParse_Cockroach(b *testing.B) {
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, _, _ = apd.NewFromString("123.45")
@@ -160,6 +177,7 @@ func BenchmarkComplex_Float64(b *testing.B) {
 	qty := 15.0
 	tax := 0.08
 	discount := 5.00
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		subtotal := price * qty
@@ -173,6 +191,7 @@ func BenchmarkComplex_Shopspring(b *testing.B) {
 	qty := shopspring.NewFromInt(15)
 	tax := shopspring.NewFromFloat(0.08)
 	discount := shopspring.NewFromFloat(5.00)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		subtotal := price.Mul(qty)
@@ -186,6 +205,7 @@ func BenchmarkComplex_Govalues(b *testing.B) {
 	qty, _ := govalues.Parse("15")
 	tax, _ := govalues.Parse("0.08")
 	discount, _ := govalues.Parse("5.00")
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		subtotal, _ := price.Mul(qty)
@@ -201,6 +221,7 @@ func BenchmarkComplex_Cockroach(b *testing.B) {
 	tax, _, _ := apd.NewFromString("0.08")
 	discount, _, _ := apd.NewFromString("5.00")
 	ctx := apd.BaseContext
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		subtotal := apd.New(0, 0)
@@ -213,3 +234,75 @@ func BenchmarkComplex_Cockroach(b *testing.B) {
 		_, _ = ctx.Sub(result, withTax, discount)
 	}
 }
+
+// =============================================================================
+// Parallel / contention benchmarks
+//
+// shopspring.Decimal and govalues.Decimal are immutable value types, so
+// sharing one across goroutines only ever means sharing read-only operands.
+// apd.Context is also safe to share read-only; each goroutine below still
+// uses its own *apd.Decimal result, since Context.Add et al. write through
+// that pointer. big.Float is the odd one out: its methods mutate the
+// receiver, so "shared receiver" there would be a data race — what's safe
+// (and realistic) to contend on is the shared read-only operands while each
+// goroutine keeps its own result, which is what BenchmarkAddition_BigFloat_Parallel
+// below does.
+// =============================================================================
+
+func BenchmarkAddition_Float64_Parallel(b *testing.B) {
+	price := 99.99
+	tax := 8.50
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = price + tax
+		}
+	})
+}
+
+func BenchmarkAddition_Shopspring_Parallel(b *testing.B) {
+	price := shopspring.NewFromFloat(99.99)
+	tax := shopspring.NewFromFloat(8.50)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = price.Add(tax)
+		}
+	})
+}
+
+func BenchmarkAddition_Govalues_Parallel(b *testing.B) {
+	price, _ := govalues.Parse("99.99")
+	tax, _ := govalues.Parse("8.50")
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = price.Add(tax)
+		}
+	})
+}
+
+func BenchmarkAddition_Cockroach_Parallel(b *testing.B) {
+	price, _, _ := apd.NewFromString("99.99")
+	tax, _, _ := apd.NewFromString("8.50")
+	ctx := apd.BaseContext // shared read-only across goroutines
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		result := apd.New(0, 0) // each goroutine owns its result
+		for pb.Next() {
+			_, _ = ctx.Add(result, price, tax)
+		}
+	})
+}
+
+func BenchmarkAddition_BigFloat_Parallel(b *testing.B) {
+	price := big.NewFloat(99.99)
+	tax := big.NewFloat(8.50)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		result := new(big.Float) // each goroutine owns its result
+		for pb.Next() {
+			result.Add(price, tax)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+/**
+ * report ingests `go test -bench=. -benchmem` output for decimalbench and
+ * emits a combined report, ranked by ns/op then B/op, as either JSON or CSV.
+ * Pass -precision pointing at precision_report.json (written by
+ * TestPrecisionDeviation) to fold each library's worst-case digits of
+ * agreement into the same table.
+ *
+ * Run: go test -bench=. -benchmem | go run ./cmd/report -precision=precision_report.json
+ */
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var benchLine = regexp.MustCompile(
+	`^Benchmark(\w+)-\d+\s+\d+\s+([\d.]+)\s+ns/op(?:\s+([\d.]+)\s+B/op)?(?:\s+([\d.]+)\s+allocs/op)?`,
+)
+
+type result struct {
+	Name        string  `json:"name"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  float64 `json:"bytes_per_op"`
+	AllocsPerOp float64 `json:"allocs_per_op"`
+	DigitsAgree *int    `json:"digits_agree,omitempty"`
+}
+
+// precisionRow mirrors decimalbench's precisionResult (duplicated rather
+// than imported, since cmd/report is a separate main package and the two
+// only need to agree on the JSON shape).
+type precisionRow struct {
+	Vector      string `json:"vector"`
+	Library     string `json:"library"`
+	DigitsAgree int    `json:"digits_agree"`
+}
+
+func main() {
+	format := flag.String("format", "json", "output format: json or csv")
+	precisionPath := flag.String("precision", "", "path to precision_report.json to join in")
+	flag.Parse()
+
+	results, err := parse(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "report:", err)
+		os.Exit(1)
+	}
+
+	if *precisionPath != "" {
+		worstDigits, err := loadWorstDigits(*precisionPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "report:", err)
+			os.Exit(1)
+		}
+		for i := range results {
+			if digits, ok := worstDigits[libraryOf(results[i].Name)]; ok {
+				d := digits
+				results[i].DigitsAgree = &d
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].NsPerOp != results[j].NsPerOp {
+			return results[i].NsPerOp < results[j].NsPerOp
+		}
+		return results[i].BytesPerOp < results[j].BytesPerOp
+	})
+
+	switch *format {
+	case "csv":
+		writeCSV(os.Stdout, results)
+	default:
+		writeJSON(os.Stdout, results)
+	}
+}
+
+// libraryOf extracts the library suffix from a benchmark name like
+// "Addition_Cockroach", matching the naming precision_report.json uses.
+func libraryOf(name string) string {
+	idx := strings.LastIndex(name, "_")
+	if idx < 0 {
+		return name
+	}
+	return strings.TrimSuffix(name[idx+1:], "_Parallel")
+}
+
+// loadWorstDigits reads precision_report.json and reduces it to each
+// library's minimum digits-of-agreement across every vector, since that
+// worst case is what should gate a library choice.
+func loadWorstDigits(path string) (map[string]int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rows []precisionRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+	worst := map[string]int{}
+	for _, row := range rows {
+		if cur, ok := worst[row.Library]; !ok || row.DigitsAgree < cur {
+			worst[row.Library] = row.DigitsAgree
+		}
+	}
+	return worst, nil
+}
+
+func parse(r io.Reader) ([]result, error) {
+	var out []result
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := benchLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		res := result{Name: m[1]}
+		res.NsPerOp, _ = strconv.ParseFloat(m[2], 64)
+		if m[3] != "" {
+			res.BytesPerOp, _ = strconv.ParseFloat(m[3], 64)
+		}
+		if m[4] != "" {
+			res.AllocsPerOp, _ = strconv.ParseFloat(m[4], 64)
+		}
+		out = append(out, res)
+	}
+	return out, scanner.Err()
+}
+
+func writeJSON(w io.Writer, results []result) {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(results)
+}
+
+func writeCSV(w io.Writer, results []result) {
+	cw := csv.NewWriter(w)
+	defer cw.Flush()
+	_ = cw.Write([]string{"name", "ns_per_op", "bytes_per_op", "allocs_per_op", "digits_agree"})
+	for _, r := range results {
+		digits := ""
+		if r.DigitsAgree != nil {
+			digits = strconv.Itoa(*r.DigitsAgree)
+		}
+		_ = cw.Write([]string{
+			r.Name,
+			strconv.FormatFloat(r.NsPerOp, 'f', 2, 64),
+			strconv.FormatFloat(r.BytesPerOp, 'f', 0, 64),
+			strconv.FormatFloat(r.AllocsPerOp, 'f', 0, 64),
+			digits,
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package decimalbench
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// BenchmarkPrecisionDrift_* isolates specific places float64 money math is
+// known to go wrong. Unlike precision.go/precision_test.go, which scores
+// every library against a single apd-at-60-digits reference, each scenario
+// here has no single "true" answer worth hand-typing — instead it runs the
+// same arithmetic through two independent decimal libraries and asserts
+// they land on the identical cent, which only holds because the operations
+// below were chosen to be exact in base 10 (sums of tenths, rounding to a
+// fixed number of places, multiply/divide by small integers). float64's
+// result is never asserted against, only reported via b.ReportMetric, since
+// its drift is the thing being measured, not a bug to fail the build over.
+
+// ---------------------------------------------------------------------------
+// Repeated 0.1 + 0.2
+// ---------------------------------------------------------------------------
+
+const driftSumIterations = 10000
+
+func BenchmarkPrecisionDrift_SumPointOneTwo(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	var floatSum float64
+	for i := 0; i < b.N; i++ {
+		floatSum = 0
+		for j := 0; j < driftSumIterations; j++ {
+			floatSum += 0.1 + 0.2
+		}
+	}
+	b.StopTimer()
+
+	tenth, _ := shopspring.NewFromString("0.1")
+	twoTenths, _ := shopspring.NewFromString("0.2")
+	shopSum := shopspring.Zero
+	for j := 0; j < driftSumIterations; j++ {
+		shopSum = shopSum.Add(tenth).Add(twoTenths)
+	}
+
+	govTenth, _ := govalues.Parse("0.1")
+	govTwoTenths, _ := govalues.Parse("0.2")
+	govSum, _ := govalues.Parse("0")
+	for j := 0; j < driftSumIterations; j++ {
+		govSum, _ = govSum.Add(govTenth)
+		govSum, _ = govSum.Add(govTwoTenths)
+	}
+
+	shopFloat, _ := shopSum.Float64()
+	govFloat := mustGovFloat64(b, govSum)
+	if shopFloat != govFloat {
+		b.Fatalf("shopspring sum %v disagrees with govalues sum %v", shopFloat, govFloat)
+	}
+	b.ReportMetric(floatSum-shopFloat, "drift_abs")
+}
+
+// ---------------------------------------------------------------------------
+// Tax rounding across a batch of line items
+// ---------------------------------------------------------------------------
+
+const (
+	driftLineItems = 10000
+	driftTaxRate   = 0.0825
+)
+
+func driftLineItemPrice(i int) float64 {
+	return 9.99 + float64(i%50)*0.37
+}
+
+func BenchmarkPrecisionDrift_TaxRounding(b *testing.B) {
+	taxRateShop := shopspring.NewFromFloat(driftTaxRate)
+	taxRateApd, _, _ := apd.NewFromString(formatFloat(driftTaxRate))
+	ctx := apd.BaseContext.WithPrecision(30)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var floatTotal float64
+	for i := 0; i < b.N; i++ {
+		floatTotal = 0
+		for j := 0; j < driftLineItems; j++ {
+			tax := math.Round(driftLineItemPrice(j)*driftTaxRate*100) / 100
+			floatTotal += tax
+		}
+	}
+	b.StopTimer()
+
+	shopTotal := shopspring.Zero
+	apdTotal := apd.New(0, 0)
+	price := apd.New(0, 0)
+	tax := apd.New(0, 0)
+	for j := 0; j < driftLineItems; j++ {
+		p := driftLineItemPrice(j)
+
+		shopTax := shopspring.NewFromFloat(p).Mul(taxRateShop).Round(2)
+		shopTotal = shopTotal.Add(shopTax)
+
+		_, _, _ = price.SetString(formatFloat(p))
+		_, _ = ctx.Mul(tax, price, taxRateApd)
+		_, _ = ctx.Quantize(tax, tax, -2)
+		_, _ = ctx.Add(apdTotal, apdTotal, tax)
+	}
+
+	shopFloat, _ := shopTotal.Float64()
+	apdFloat, err := apdTotal.Float64()
+	if err != nil {
+		b.Fatalf("apd tax total not representable as float64: %v", err)
+	}
+	if shopFloat != apdFloat {
+		b.Fatalf("shopspring tax total %v disagrees with apd tax total %v", shopFloat, apdFloat)
+	}
+	b.ReportMetric(floatTotal-shopFloat, "drift_abs")
+}
+
+// ---------------------------------------------------------------------------
+// USD -> EUR -> USD round-trip
+// ---------------------------------------------------------------------------
+
+const driftFxRate = 0.92 // USD -> EUR
+
+func BenchmarkPrecisionDrift_CurrencyRoundTrip(b *testing.B) {
+	rateShop := shopspring.NewFromFloat(driftFxRate)
+	rateApd, _, _ := apd.NewFromString(formatFloat(driftFxRate))
+	ctx := apd.BaseContext.WithPrecision(30)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	var floatResult float64
+	for i := 0; i < b.N; i++ {
+		amount := 1000.00
+		eur := math.Round(amount*driftFxRate*100) / 100
+		floatResult = math.Round(eur/driftFxRate*100) / 100
+	}
+	b.StopTimer()
+
+	amountShop := shopspring.NewFromFloat(1000.00)
+	eurShop := amountShop.Mul(rateShop).Round(2)
+	usdShop := eurShop.DivRound(rateShop, 10).Round(2)
+
+	amountApd, _, _ := apd.NewFromString("1000.00")
+	eurApd := apd.New(0, 0)
+	_, _ = ctx.Mul(eurApd, amountApd, rateApd)
+	_, _ = ctx.Quantize(eurApd, eurApd, -2)
+	usdApd := apd.New(0, 0)
+	_, _ = ctx.Quo(usdApd, eurApd, rateApd)
+	_, _ = ctx.Quantize(usdApd, usdApd, -2)
+
+	shopFloat, _ := usdShop.Float64()
+	apdFloat, err := usdApd.Float64()
+	if err != nil {
+		b.Fatalf("apd result not representable as float64: %v", err)
+	}
+	if shopFloat != apdFloat {
+		b.Fatalf("shopspring round-trip %v disagrees with apd round-trip %v", shopFloat, apdFloat)
+	}
+	b.ReportMetric(floatResult-shopFloat, "drift_abs")
+}
+
+// ---------------------------------------------------------------------------
+// Iterative multiply/divide chain
+//
+// Multiplying by 3 and dividing by 2 both stay exact in base 10 (the
+// denominator only ever picks up factors of 2), so a correct decimal
+// implementation should return to the starting value with zero error no
+// matter how many rounds run; float64 drifts because 1/2 isn't the only
+// thing happening under the hood of binary floating point rounding.
+// ---------------------------------------------------------------------------
+
+const driftChainRounds = 40
+
+func BenchmarkPrecisionDrift_MulDivChain(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	var floatValue float64
+	for i := 0; i < b.N; i++ {
+		floatValue = 100.0
+		for r := 0; r < driftChainRounds; r++ {
+			floatValue = floatValue * 3 / 2
+			floatValue = floatValue * 2 / 3
+		}
+	}
+	b.StopTimer()
+
+	shopValue, _ := shopspring.NewFromString("100")
+	three := shopspring.NewFromInt(3)
+	two := shopspring.NewFromInt(2)
+	for r := 0; r < driftChainRounds; r++ {
+		shopValue = shopValue.Mul(three).Div(two)
+		shopValue = shopValue.Mul(two).Div(three)
+	}
+
+	govValue, _ := govalues.Parse("100")
+	govThree, _ := govalues.Parse("3")
+	govTwo, _ := govalues.Parse("2")
+	for r := 0; r < driftChainRounds; r++ {
+		govValue, _ = govValue.Mul(govThree)
+		govValue, _ = govValue.Quo(govTwo)
+		govValue, _ = govValue.Mul(govTwo)
+		govValue, _ = govValue.Quo(govThree)
+	}
+
+	shopFloat, _ := shopValue.Float64()
+	govFloat, _ := govValue.Float64()
+	if shopFloat != govFloat {
+		b.Fatalf("shopspring chain result %v disagrees with govalues chain result %v", shopFloat, govFloat)
+	}
+	b.ReportMetric(floatValue-shopFloat, "drift_abs")
+}
+
+func mustGovFloat64(b *testing.B, d govalues.Decimal) float64 {
+	b.Helper()
+	f, err := d.Float64()
+	if err != nil {
+		b.Fatalf("converting govalues decimal to float64: %v", err)
+	}
+	return f
+}
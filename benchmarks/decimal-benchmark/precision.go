@@ -0,0 +1,256 @@
+package decimalbench
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/alpacahq/alpacadecimal"
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	"github.com/robaho/fixed"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// referenceCtx computes "ground truth" at far more precision than any of the
+// libraries under test carry, so its results can stand in for the exact
+// mathematical answer when scoring everyone else's deviation.
+var referenceCtx = apd.BaseContext.WithPrecision(60)
+
+// op identifies which arithmetic operation a vector exercises.
+type op int
+
+const (
+	opAdd op = iota
+	opMul
+	opQuo
+	opSqrt
+)
+
+// vector is one precision test case: two decimal string operands (b unused
+// for opSqrt) run through op on every library and compared against the
+// apd-at-60-digits reference.
+type vector struct {
+	Name string
+	Op   op
+	A, B string
+}
+
+// vectors covers the classes of input that tend to expose precision bugs:
+// mixed-scale addition, a long multiply, a division that repeats forever in
+// decimal, sqrt of a non-square, the canonical 0.1+0.2 float footgun, and a
+// very small magnitude that pushes float64 toward its subnormal range.
+var vectors = []vector{
+	{Name: "MixedScaleAdd", Op: opAdd, A: "1.1", B: "2.22"},
+	{Name: "LongMultiply", Op: opMul, A: "123456789.123456789", B: "987654321.987654321"},
+	{Name: "RepeatingDivision", Op: opQuo, A: "10", B: "3"},
+	{Name: "SqrtNonSquare", Op: opSqrt, A: "2"},
+	{Name: "ClassicFloatAdd", Op: opAdd, A: "0.1", B: "0.2"},
+	{Name: "TinyMagnitudeMultiply", Op: opMul, A: "0.0000000000000000001", B: "0.0000000000000000001"},
+}
+
+// reference evaluates v at 60-digit precision, standing in for the exact
+// answer.
+func (v vector) reference() *apd.Decimal {
+	a, _, _ := apd.NewFromString(v.A)
+	result := new(apd.Decimal)
+	switch v.Op {
+	case opAdd:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = referenceCtx.Add(result, a, b)
+	case opMul:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = referenceCtx.Mul(result, a, b)
+	case opQuo:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = referenceCtx.Quo(result, a, b)
+	case opSqrt:
+		_, _ = referenceCtx.Sqrt(result, a)
+	}
+	return result
+}
+
+// evalFloat64, evalShopspring, evalGovalues, and evalBigFloat each compute v
+// under their library at its normal working precision, returned as an
+// apd.Decimal (at reference precision) so deviation can use apd's own
+// subtraction/division to score accuracy.
+func evalFloat64(v vector) *apd.Decimal {
+	a, _ := parseFloat(v.A)
+	var result float64
+	switch v.Op {
+	case opAdd:
+		b, _ := parseFloat(v.B)
+		result = a + b
+	case opMul:
+		b, _ := parseFloat(v.B)
+		result = a * b
+	case opQuo:
+		b, _ := parseFloat(v.B)
+		result = a / b
+	case opSqrt:
+		result = math.Sqrt(a)
+	}
+	d, _, _ := apd.NewFromString(formatFloat(result))
+	return d
+}
+
+func evalShopspring(v vector) *apd.Decimal {
+	a, _ := shopspring.NewFromString(v.A)
+	var result shopspring.Decimal
+	switch v.Op {
+	case opAdd:
+		b, _ := shopspring.NewFromString(v.B)
+		result = a.Add(b)
+	case opMul:
+		b, _ := shopspring.NewFromString(v.B)
+		result = a.Mul(b)
+	case opQuo:
+		b, _ := shopspring.NewFromString(v.B)
+		result = a.DivRound(b, 40)
+	case opSqrt:
+		f, _ := a.Float64()
+		result = shopspring.NewFromFloat(math.Sqrt(f))
+	}
+	d, _, _ := apd.NewFromString(result.String())
+	return d
+}
+
+func evalGovalues(v vector) *apd.Decimal {
+	a, _ := govalues.Parse(v.A)
+	var result govalues.Decimal
+	switch v.Op {
+	case opAdd:
+		b, _ := govalues.Parse(v.B)
+		result, _ = a.Add(b)
+	case opMul:
+		b, _ := govalues.Parse(v.B)
+		result, _ = a.Mul(b)
+	case opQuo:
+		b, _ := govalues.Parse(v.B)
+		result, _ = a.Quo(b)
+	case opSqrt:
+		f, _ := a.Float64()
+		result, _ = govalues.NewFromFloat64(math.Sqrt(f))
+	}
+	d, _, _ := apd.NewFromString(result.String())
+	return d
+}
+
+func evalBigFloat(v vector) *apd.Decimal {
+	prec := uint(200)
+	a, _, _ := big.ParseFloat(v.A, 10, prec, big.ToNearestEven)
+	result := new(big.Float).SetPrec(prec)
+	switch v.Op {
+	case opAdd:
+		b, _, _ := big.ParseFloat(v.B, 10, prec, big.ToNearestEven)
+		result.Add(a, b)
+	case opMul:
+		b, _, _ := big.ParseFloat(v.B, 10, prec, big.ToNearestEven)
+		result.Mul(a, b)
+	case opQuo:
+		b, _, _ := big.ParseFloat(v.B, 10, prec, big.ToNearestEven)
+		result.Quo(a, b)
+	case opSqrt:
+		result.Sqrt(a)
+	}
+	d, _, _ := apd.NewFromString(result.Text('f', 60))
+	return d
+}
+
+// deviation returns |got-want| and |got-want|/|want| (0 if want is zero),
+// both computed at reference precision so the comparison itself doesn't
+// introduce new error.
+func deviation(got, want *apd.Decimal) (abs, rel *apd.Decimal) {
+	abs = new(apd.Decimal)
+	_, _ = referenceCtx.Sub(abs, got, want)
+	_, _ = referenceCtx.Abs(abs, abs)
+
+	rel = new(apd.Decimal)
+	if want.IsZero() {
+		return abs, rel
+	}
+	_, _ = referenceCtx.Quo(rel, abs, want)
+	_, _ = referenceCtx.Abs(rel, rel)
+	return abs, rel
+}
+
+// workingCtx is the precision a user would actually reach for with apd (16
+// digits, matching the BaseContext.WithPrecision(16) used in the speed
+// benchmarks), as opposed to referenceCtx's 60 digits.
+var workingCtx = apd.BaseContext.WithPrecision(16)
+
+func evalAPD(v vector) *apd.Decimal {
+	a, _, _ := apd.NewFromString(v.A)
+	result := new(apd.Decimal)
+	switch v.Op {
+	case opAdd:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = workingCtx.Add(result, a, b)
+	case opMul:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = workingCtx.Mul(result, a, b)
+	case opQuo:
+		b, _, _ := apd.NewFromString(v.B)
+		_, _ = workingCtx.Quo(result, a, b)
+	case opSqrt:
+		_, _ = workingCtx.Sqrt(result, a)
+	}
+	return result
+}
+
+func evalFixed(v vector) *apd.Decimal {
+	af, _ := parseFloat(v.A)
+	a := fixed.NewF(af)
+	var result fixed.Fixed
+	switch v.Op {
+	case opAdd:
+		bf, _ := parseFloat(v.B)
+		result = a.Add(fixed.NewF(bf))
+	case opMul:
+		bf, _ := parseFloat(v.B)
+		result = a.Mul(fixed.NewF(bf))
+	case opQuo:
+		bf, _ := parseFloat(v.B)
+		result = a.Div(fixed.NewF(bf))
+	case opSqrt:
+		// robaho/fixed has no Sqrt; fall back to float64 at its own scale,
+		// which is exactly what a user reaching for this library would do.
+		result = fixed.NewF(math.Sqrt(af))
+	}
+	d, _, _ := apd.NewFromString(result.String())
+	return d
+}
+
+func evalAlpaca(v vector) *apd.Decimal {
+	af, _ := parseFloat(v.A)
+	a := alpacadecimal.NewFromFloat(af)
+	var result alpacadecimal.Decimal
+	switch v.Op {
+	case opAdd:
+		bf, _ := parseFloat(v.B)
+		result = a.Add(alpacadecimal.NewFromFloat(bf))
+	case opMul:
+		bf, _ := parseFloat(v.B)
+		result = a.Mul(alpacadecimal.NewFromFloat(bf))
+	case opQuo:
+		bf, _ := parseFloat(v.B)
+		result = a.Div(alpacadecimal.NewFromFloat(bf))
+	case opSqrt:
+		result = alpacadecimal.NewFromFloat(math.Sqrt(af))
+	}
+	d, _, _ := apd.NewFromString(result.String())
+	return d
+}
+
+func parseFloat(s string) (float64, error) {
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return 0, err
+	}
+	f, err := d.Float64()
+	return f, err
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
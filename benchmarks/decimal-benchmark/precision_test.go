@@ -0,0 +1,92 @@
+package decimalbench
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+)
+
+// evalLibraries pairs a name (matching the *_<Library> suffix used by the
+// speed benchmarks above) with the function that evaluates a vector under
+// it, each returned as an apd.Decimal at reference precision so deviation
+// can compare everyone through the same arithmetic.
+var evalLibraries = []struct {
+	Name string
+	Eval func(vector) *apd.Decimal
+}{
+	{"Float64", evalFloat64},
+	{"BigFloat", evalBigFloat},
+	{"Shopspring", evalShopspring},
+	{"Govalues", evalGovalues},
+	{"Cockroach", evalAPD},
+	{"Fixed", evalFixed},
+	{"Alpaca", evalAlpaca},
+}
+
+// precisionResult is one (vector, library) row of the accuracy table. It's
+// shaped to join with the ns/op table that cmd/report produces: Library and
+// Vector are enough to line the two tables up by hand.
+type precisionResult struct {
+	Vector      string `json:"vector"`
+	Library     string `json:"library"`
+	AbsError    string `json:"abs_error"`
+	RelError    string `json:"rel_error"`
+	DigitsAgree int    `json:"digits_agree"`
+}
+
+// TestPrecisionDeviation scores every library against the apd-at-60-digits
+// reference for each vector, and writes precision_report.json so
+// cmd/report can join ns/op against accuracy in one place. It never fails:
+// the point is to report the tradeoff, not to enforce a bar, since some
+// libraries (float64, robaho/fixed) are expected to diverge by design.
+func TestPrecisionDeviation(t *testing.T) {
+	var results []precisionResult
+	for _, v := range vectors {
+		want := v.reference()
+		for _, lib := range evalLibraries {
+			got := lib.Eval(v)
+			abs, rel := deviation(got, want)
+			digits := digitsOfAgreement(rel)
+			results = append(results, precisionResult{
+				Vector:      v.Name,
+				Library:     lib.Name,
+				AbsError:    abs.String(),
+				RelError:    rel.String(),
+				DigitsAgree: digits,
+			})
+			t.Logf("%-24s %-12s abs=%-14s rel=%-14s digits=%d", v.Name, lib.Name, abs.String(), rel.String(), digits)
+		}
+	}
+
+	f, err := os.Create("precision_report.json")
+	if err != nil {
+		t.Fatalf("creating precision_report.json: %v", err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(results); err != nil {
+		t.Fatalf("writing precision_report.json: %v", err)
+	}
+}
+
+// digitsOfAgreement converts a relative error into an approximate count of
+// correct significant digits; a zero relative error reports as fully
+// precise at the reference's own precision.
+func digitsOfAgreement(rel *apd.Decimal) int {
+	if rel.IsZero() {
+		return int(referenceCtx.Precision)
+	}
+	f, err := rel.Float64()
+	if err != nil || f <= 0 {
+		return 0
+	}
+	digits := int(-math.Log10(f))
+	if digits < 0 {
+		return 0
+	}
+	return digits
+}
@@ -0,0 +1,50 @@
+package decimalbench
+
+import "math"
+
+// roundHalfUp, roundHalfEven, roundDown, and roundCeiling give float64 the
+// same four rounding modes the decimal libraries below expose natively.
+// They exist so the benchmarks can show float64's cost for an apples-to-
+// apples comparison — not because they're a good way to round money; see
+// TestRoundingEdgeCases for where operating on a binary float64 instead of
+// a base-10 value breaks even a mode implemented correctly.
+
+func roundHalfUp(x float64, places int) float64 {
+	shift := math.Pow(10, float64(places))
+	v := x * shift
+	if v >= 0 {
+		return math.Floor(v+0.5) / shift
+	}
+	return math.Ceil(v-0.5) / shift
+}
+
+func roundHalfEven(x float64, places int) float64 {
+	shift := math.Pow(10, float64(places))
+	v := x * shift
+	floor := math.Floor(v)
+	diff := v - floor
+	switch {
+	case diff < 0.5:
+		return floor / shift
+	case diff > 0.5:
+		return (floor + 1) / shift
+	default:
+		if math.Mod(floor, 2) == 0 {
+			return floor / shift
+		}
+		return (floor + 1) / shift
+	}
+}
+
+func roundDown(x float64, places int) float64 {
+	shift := math.Pow(10, float64(places))
+	if x >= 0 {
+		return math.Floor(x*shift) / shift
+	}
+	return math.Ceil(x*shift) / shift
+}
+
+func roundCeiling(x float64, places int) float64 {
+	shift := math.Pow(10, float64(places))
+	return math.Ceil(x*shift) / shift
+}
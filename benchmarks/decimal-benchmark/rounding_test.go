@@ -0,0 +1,274 @@
+package decimalbench
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// roundValue is a representative price with a digit sitting exactly on a
+// rounding boundary at 2 decimal places.
+const roundValue = 1234.565
+
+func apdRoundingContext(mode apd.Rounder) *apd.Context {
+	ctx := apd.BaseContext.WithPrecision(30)
+	ctx.Rounding = mode
+	return ctx
+}
+
+// ---------------------------------------------------------------------------
+// HALF_EVEN (banker's rounding)
+// ---------------------------------------------------------------------------
+
+func BenchmarkRound_HalfEven_Float64(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = roundHalfEven(roundValue, 2)
+	}
+}
+
+func BenchmarkRound_HalfEven_Shopspring(b *testing.B) {
+	d := shopspring.NewFromFloat(roundValue)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.RoundBank(2)
+	}
+}
+
+func BenchmarkRound_HalfEven_Govalues(b *testing.B) {
+	d, _ := govalues.NewFromFloat64(roundValue)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Round(2)
+	}
+}
+
+func BenchmarkRound_HalfEven_Cockroach(b *testing.B) {
+	d, _, _ := apd.NewFromString(formatFloat(roundValue))
+	ctx := apdRoundingContext(apd.RoundHalfEven)
+	result := apd.New(0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Quantize(result, d, -2)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// HALF_UP (round half away from zero)
+// ---------------------------------------------------------------------------
+
+func BenchmarkRound_HalfUp_Float64(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = roundHalfUp(roundValue, 2)
+	}
+}
+
+func BenchmarkRound_HalfUp_Shopspring(b *testing.B) {
+	d := shopspring.NewFromFloat(roundValue)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Round(2)
+	}
+}
+
+func BenchmarkRound_HalfUp_Cockroach(b *testing.B) {
+	d, _, _ := apd.NewFromString(formatFloat(roundValue))
+	ctx := apdRoundingContext(apd.RoundHalfUp)
+	result := apd.New(0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Quantize(result, d, -2)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// DOWN (truncate toward zero)
+// ---------------------------------------------------------------------------
+
+func BenchmarkRound_Down_Float64(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = roundDown(roundValue, 2)
+	}
+}
+
+func BenchmarkRound_Down_Shopspring(b *testing.B) {
+	d := shopspring.NewFromFloat(roundValue)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.RoundDown(2)
+	}
+}
+
+func BenchmarkRound_Down_Cockroach(b *testing.B) {
+	d, _, _ := apd.NewFromString(formatFloat(roundValue))
+	ctx := apdRoundingContext(apd.RoundDown)
+	result := apd.New(0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Quantize(result, d, -2)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// CEILING (round toward positive infinity)
+// ---------------------------------------------------------------------------
+
+func BenchmarkRound_Ceiling_Float64(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = roundCeiling(roundValue, 2)
+	}
+}
+
+func BenchmarkRound_Ceiling_Shopspring(b *testing.B) {
+	d := shopspring.NewFromFloat(roundValue)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.RoundCeil(2)
+	}
+}
+
+func BenchmarkRound_Ceiling_Cockroach(b *testing.B) {
+	d, _, _ := apd.NewFromString(formatFloat(roundValue))
+	ctx := apdRoundingContext(apd.RoundCeiling)
+	result := apd.New(0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Quantize(result, d, -2)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Batch quantize: rescale 1000 values to 2 places using each library's
+// default rounding (HALF_EVEN for govalues and apd's BaseContext, round
+// half away from zero for shopspring's plain Round).
+// ---------------------------------------------------------------------------
+
+func quantizeBatchValues(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = 1.005 + float64(i)*0.0137
+	}
+	return values
+}
+
+func BenchmarkQuantize_Batch_Float64(b *testing.B) {
+	values := quantizeBatchValues(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range values {
+			_ = roundHalfEven(v, 2)
+		}
+	}
+}
+
+func BenchmarkQuantize_Batch_Shopspring(b *testing.B) {
+	values := quantizeBatchValues(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range values {
+			_ = shopspring.NewFromFloat(v).Round(2)
+		}
+	}
+}
+
+func BenchmarkQuantize_Batch_Govalues(b *testing.B) {
+	values := quantizeBatchValues(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range values {
+			d, _ := govalues.NewFromFloat64(v)
+			_ = d.Round(2)
+		}
+	}
+}
+
+func BenchmarkQuantize_Batch_Cockroach(b *testing.B) {
+	values := quantizeBatchValues(1000)
+	ctx := apd.BaseContext.WithPrecision(30)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := apd.New(0, 0)
+		result := apd.New(0, 0)
+		for _, v := range values {
+			_, _, _ = d.SetString(formatFloat(v))
+			_, _ = ctx.Quantize(result, d, -2)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Edge cases: classic boundary values where the four modes diverge.
+// Inputs are parsed from exact decimal strings, not float64 literals — 1.235
+// as a float64 constant is actually 1.23499999999999988..., so asserting a
+// decimal library's output against "what 1.235 looks like to a compiler"
+// would just be testing float64's own rounding error, not the library.
+// ---------------------------------------------------------------------------
+
+func TestRoundingEdgeCases(t *testing.T) {
+	cases := []struct {
+		input                            string
+		halfUp, halfEven, down, ceiling string
+	}{
+		{input: "1.235", halfUp: "1.24", halfEven: "1.24", down: "1.23", ceiling: "1.24"},
+		{input: "2.675", halfUp: "2.68", halfEven: "2.68", down: "2.67", ceiling: "2.68"},
+		{input: "-1.005", halfUp: "-1.01", halfEven: "-1.00", down: "-1.00", ceiling: "-1.00"},
+	}
+
+	for _, c := range cases {
+		shop := shopspring.RequireFromString(c.input)
+		if got := shop.Round(2).String(); got != c.halfUp {
+			t.Errorf("shopspring Round(%s) = %s, want %s", c.input, got, c.halfUp)
+		}
+		if got := shop.RoundBank(2).String(); got != c.halfEven {
+			t.Errorf("shopspring RoundBank(%s) = %s, want %s", c.input, got, c.halfEven)
+		}
+		if got := shop.RoundDown(2).String(); got != c.down {
+			t.Errorf("shopspring RoundDown(%s) = %s, want %s", c.input, got, c.down)
+		}
+		if got := shop.RoundCeil(2).String(); got != c.ceiling {
+			t.Errorf("shopspring RoundCeil(%s) = %s, want %s", c.input, got, c.ceiling)
+		}
+
+		d, _, err := apd.NewFromString(c.input)
+		if err != nil {
+			t.Fatalf("apd.NewFromString(%s): %v", c.input, err)
+		}
+		result := apd.New(0, 0)
+		for mode, want := range map[apd.Rounder]string{
+			apd.RoundHalfUp:   c.halfUp,
+			apd.RoundHalfEven: c.halfEven,
+			apd.RoundDown:     c.down,
+			apd.RoundCeiling:  c.ceiling,
+		} {
+			ctx := apdRoundingContext(mode)
+			if _, err := ctx.Quantize(result, d, -2); err != nil {
+				t.Fatalf("Quantize(%s, mode=%v): %v", c.input, mode, err)
+			}
+			if got := result.String(); got != want {
+				t.Errorf("apd Quantize(%s, mode=%v) = %s, want %s", c.input, mode, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package decimalbench
+
+import "math"
+
+// fill is one trade execution: a buy adds to the position, a sell reduces
+// it (capped at the current position size, so the replay never goes short).
+type fill struct {
+	Side  string // "buy" or "sell"
+	Qty   float64
+	Price float64
+}
+
+const (
+	numFills = 1000
+	feeRate  = 0.00075
+)
+
+// generateFills produces a deterministic sequence of fills: roughly one
+// sell for every two buys, at a price that wanders via a sine wave so the
+// weighted average entry price actually moves instead of staying flat.
+func generateFills(n int) []fill {
+	fills := make([]fill, n)
+	price := 100.0
+	for i := 0; i < n; i++ {
+		price += math.Sin(float64(i)/10) * 0.5
+		side := "buy"
+		if i%3 == 0 {
+			side = "sell"
+		}
+		fills[i] = fill{
+			Side:  side,
+			Qty:   1.0 + float64(i%7),
+			Price: price,
+		}
+	}
+	return fills
+}
@@ -0,0 +1,160 @@
+package decimalbench
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// BenchmarkTradingBalance_* replay generateFills(numFills) against a
+// minimal long-only position keeper: each buy folds into a running weighted
+// average entry price (oldPos*oldAvg + newQty*newPrice)/(oldPos+newQty),
+// each sell realizes PnL against that average and is capped at the current
+// position so it never goes short, and every fill pays feeRate in fees.
+// This is the shape of the arithmetic real position-keeping code runs on
+// every fill, as opposed to the single-operation benchmarks above.
+
+func BenchmarkTradingBalance_Float64(b *testing.B) {
+	fills := generateFills(numFills)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var pos, avgEntry, fees, realizedPnL float64
+		for _, f := range fills {
+			fee := f.Qty * f.Price * feeRate
+			fees += fee
+			if f.Side == "buy" {
+				newPos := pos + f.Qty
+				avgEntry = (pos*avgEntry + f.Qty*f.Price) / newPos
+				pos = newPos
+			} else {
+				sellQty := f.Qty
+				if sellQty > pos {
+					sellQty = pos
+				}
+				realizedPnL += sellQty*(f.Price-avgEntry) - fee
+				pos -= sellQty
+			}
+		}
+		_, _, _ = pos, fees, realizedPnL
+	}
+}
+
+func BenchmarkTradingBalance_Shopspring(b *testing.B) {
+	fills := generateFills(numFills)
+	feeRateD := shopspring.NewFromFloat(feeRate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pos := shopspring.Zero
+		avgEntry := shopspring.Zero
+		fees := shopspring.Zero
+		realizedPnL := shopspring.Zero
+		for _, f := range fills {
+			qty := shopspring.NewFromFloat(f.Qty)
+			price := shopspring.NewFromFloat(f.Price)
+			fee := qty.Mul(price).Mul(feeRateD)
+			fees = fees.Add(fee)
+			if f.Side == "buy" {
+				newPos := pos.Add(qty)
+				avgEntry = pos.Mul(avgEntry).Add(qty.Mul(price)).DivRound(newPos, 8)
+				pos = newPos
+			} else {
+				sellQty := qty
+				if sellQty.GreaterThan(pos) {
+					sellQty = pos
+				}
+				realizedPnL = realizedPnL.Add(sellQty.Mul(price.Sub(avgEntry))).Sub(fee)
+				pos = pos.Sub(sellQty)
+			}
+		}
+		_, _, _ = pos, fees, realizedPnL
+	}
+}
+
+func BenchmarkTradingBalance_Govalues(b *testing.B) {
+	fills := generateFills(numFills)
+	feeRateD, _ := govalues.NewFromFloat64(feeRate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pos, _ := govalues.Parse("0")
+		avgEntry, _ := govalues.Parse("0")
+		fees, _ := govalues.Parse("0")
+		realizedPnL, _ := govalues.Parse("0")
+		for _, f := range fills {
+			qty, _ := govalues.NewFromFloat64(f.Qty)
+			price, _ := govalues.NewFromFloat64(f.Price)
+			notional, _ := qty.Mul(price)
+			fee, _ := notional.Mul(feeRateD)
+			fees, _ = fees.Add(fee)
+			if f.Side == "buy" {
+				newPos, _ := pos.Add(qty)
+				posAvg, _ := pos.Mul(avgEntry)
+				numerator, _ := posAvg.Add(notional)
+				avgEntry, _ = numerator.Quo(newPos)
+				pos = newPos
+			} else {
+				sellQty := qty
+				if sellQty.Cmp(pos) > 0 {
+					sellQty = pos
+				}
+				diff, _ := price.Sub(avgEntry)
+				gain, _ := sellQty.Mul(diff)
+				realizedPnL, _ = realizedPnL.Add(gain)
+				realizedPnL, _ = realizedPnL.Sub(fee)
+				pos, _ = pos.Sub(sellQty)
+			}
+		}
+		_, _, _ = pos, fees, realizedPnL
+	}
+}
+
+func BenchmarkTradingBalance_Cockroach(b *testing.B) {
+	fills := generateFills(numFills)
+	feeRateD, _, _ := apd.NewFromString(formatFloat(feeRate))
+	ctx := apd.BaseContext.WithPrecision(30)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pos := apd.New(0, 0)
+		avgEntry := apd.New(0, 0)
+		fees := apd.New(0, 0)
+		realizedPnL := apd.New(0, 0)
+		qty := apd.New(0, 0)
+		price := apd.New(0, 0)
+		fee := apd.New(0, 0)
+		newPos := apd.New(0, 0)
+		tmp1 := apd.New(0, 0)
+		tmp2 := apd.New(0, 0)
+		sellQty := apd.New(0, 0)
+		for _, f := range fills {
+			_, _, _ = qty.SetString(formatFloat(f.Qty))
+			_, _, _ = price.SetString(formatFloat(f.Price))
+			_, _ = ctx.Mul(fee, qty, price)
+			_, _ = ctx.Mul(fee, fee, feeRateD)
+			_, _ = ctx.Add(fees, fees, fee)
+			if f.Side == "buy" {
+				_, _ = ctx.Add(newPos, pos, qty)
+				_, _ = ctx.Mul(tmp1, pos, avgEntry)
+				_, _ = ctx.Mul(tmp2, qty, price)
+				_, _ = ctx.Add(tmp1, tmp1, tmp2)
+				_, _ = ctx.Quo(avgEntry, tmp1, newPos)
+				pos.Set(newPos)
+			} else {
+				sellQty.Set(qty)
+				if sellQty.Cmp(pos) > 0 {
+					sellQty.Set(pos)
+				}
+				_, _ = ctx.Sub(tmp1, price, avgEntry)
+				_, _ = ctx.Mul(tmp1, sellQty, tmp1)
+				_, _ = ctx.Add(realizedPnL, realizedPnL, tmp1)
+				_, _ = ctx.Sub(realizedPnL, realizedPnL, fee)
+				_, _ = ctx.Sub(pos, pos, sellQty)
+			}
+		}
+		_ = fees
+	}
+}
@@ -0,0 +1,91 @@
+package decimalbench
+
+import (
+	"math"
+
+	"github.com/alpacahq/alpacadecimal"
+	"github.com/robaho/fixed"
+)
+
+// taylorTerms bounds the Taylor-series fallback below. 24 terms is enough
+// for exp/ln to converge to fixed.Fixed's and alpacadecimal's working
+// precision across the input ranges these benchmarks use.
+const taylorTerms = 24
+
+// fixedExp computes e^x via its Taylor series entirely in robaho/fixed
+// arithmetic, since the library has no native Exp. term_n = x^n/n!, built up
+// incrementally so neither x^n nor n! ever gets computed from scratch.
+func fixedExp(x fixed.Fixed) fixed.Fixed {
+	sum := fixed.NewF(1)
+	term := fixed.NewF(1)
+	for n := 1; n <= taylorTerms; n++ {
+		term = term.Mul(x).Div(fixed.NewF(float64(n)))
+		sum = sum.Add(term)
+	}
+	return sum
+}
+
+// fixedLn computes ln(x) by Newton's method on f(y) = exp(y) - x, using
+// fixedExp above as the only transcendental primitive it needs. This is the
+// same "build the hard op on top of the easy one" approach a CORDIC table
+// takes, just in Newton-iteration form instead of angle rotations.
+func fixedLn(x fixed.Fixed) fixed.Fixed {
+	xf := x.Float()
+	y := fixed.NewF(math.Log(xf)) // seed close to the answer so Newton converges fast
+	for i := 0; i < 6; i++ {
+		ey := fixedExp(y)
+		// y_{n+1} = y_n - (e^y_n - x) / e^y_n
+		y = y.Sub(ey.Sub(x).Div(ey))
+	}
+	return y
+}
+
+// alpacaExp and alpacaLn are the alpacadecimal equivalents of fixedExp and
+// fixedLn above, same algorithms, different library.
+func alpacaExp(x alpacadecimal.Decimal) alpacadecimal.Decimal {
+	sum := alpacadecimal.NewFromFloat(1)
+	term := alpacadecimal.NewFromFloat(1)
+	for n := 1; n <= taylorTerms; n++ {
+		term = term.Mul(x).Div(alpacadecimal.NewFromFloat(float64(n)))
+		sum = sum.Add(term)
+	}
+	return sum
+}
+
+func alpacaLn(x alpacadecimal.Decimal) alpacadecimal.Decimal {
+	y := alpacadecimal.NewFromFloat(math.Log(x.Float64()))
+	for i := 0; i < 6; i++ {
+		ey := alpacaExp(y)
+		y = y.Sub(ey.Sub(x).Div(ey))
+	}
+	return y
+}
+
+// erf approximates the error function via the Abramowitz & Stegun 7.1.26
+// polynomial (max error ~1.5e-7). None of the libraries benchmarked here
+// carry a native erf, so Black-Scholes below drops to float64 for this one
+// piece on every library — exactly what a real pricing engine does even
+// when it keeps ledger amounts in decimal.
+func erf(x float64) float64 {
+	sign := 1.0
+	if x < 0 {
+		sign = -1
+		x = -x
+	}
+	const a1, a2, a3, a4, a5, p = 0.254829592, -0.284496736, 1.421413741, -1.453152027, 1.061405429, 0.3275911
+	t := 1 / (1 + p*x)
+	y := 1 - (((((a5*t+a4)*t)+a3)*t+a2)*t+a1)*t*math.Exp(-x*x)
+	return sign * y
+}
+
+func normalCDF(x float64) float64 {
+	return 0.5 * (1 + erf(x/math.Sqrt2))
+}
+
+// blackScholesCall prices a European call. S=spot, K=strike, r=risk-free
+// rate, sigma=volatility, T=years to expiry.
+func blackScholesCall(S, K, r, sigma, T float64) float64 {
+	d1 := (math.Log(S/K) + (r+sigma*sigma/2)*T) / (sigma * math.Sqrt(T))
+	d2 := d1 - sigma*math.Sqrt(T)
+	return S*normalCDF(d1) - K*math.Exp(-r*T)*normalCDF(d2)
+}
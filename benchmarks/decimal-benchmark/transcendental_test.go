@@ -0,0 +1,481 @@
+package decimalbench
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/alpacahq/alpacadecimal"
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	"github.com/robaho/fixed"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// =============================================================================
+// Exp / Ln / Pow
+//
+// Add/Mul/Div/Sqrt above are cheap for every library, which hides what these
+// benchmarks show: only float64 and apd carry Exp/Ln/Pow natively.
+// shopspring, govalues, and big.Float have no exponential/logarithm API at
+// all, so the "_Fallback" benchmarks below round-trip through float64 —
+// exactly what a caller reaching for those libraries does in practice.
+// robaho/fixed and alpacadecimal are also missing Exp/Ln, but since both
+// already carry enough decimal arithmetic to build it themselves, their
+// "_Fallback" benchmarks instead use the Taylor-series/Newton fallback in
+// transcendental.go, which stays entirely in the library's own type.
+// =============================================================================
+
+func BenchmarkExp_Float64_Native(b *testing.B) {
+	x := 1.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = math.Exp(x)
+	}
+}
+
+func BenchmarkExp_Cockroach_Native(b *testing.B) {
+	x, _, _ := apd.NewFromString("1.5")
+	result := apd.New(0, 0)
+	ctx := apd.BaseContext
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Exp(result, x)
+	}
+}
+
+func BenchmarkExp_BigFloat_Fallback(b *testing.B) {
+	x := big.NewFloat(1.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = new(big.Float).SetFloat64(math.Exp(xf))
+	}
+}
+
+func BenchmarkExp_Shopspring_Fallback(b *testing.B) {
+	x := shopspring.NewFromFloat(1.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = shopspring.NewFromFloat(math.Exp(xf))
+	}
+}
+
+func BenchmarkExp_Govalues_Fallback(b *testing.B) {
+	x, _ := govalues.Parse("1.5")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_, _ = govalues.NewFromFloat64(math.Exp(xf))
+	}
+}
+
+func BenchmarkExp_Fixed_Fallback(b *testing.B) {
+	x := fixed.NewF(1.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = fixedExp(x)
+	}
+}
+
+func BenchmarkExp_Alpaca_Fallback(b *testing.B) {
+	x := alpacadecimal.NewFromFloat(1.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = alpacaExp(x)
+	}
+}
+
+func BenchmarkLog_Float64_Native(b *testing.B) {
+	x := 42.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = math.Log(x)
+	}
+}
+
+func BenchmarkLog_Cockroach_Native(b *testing.B) {
+	x, _, _ := apd.NewFromString("42.5")
+	result := apd.New(0, 0)
+	ctx := apd.BaseContext
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Ln(result, x)
+	}
+}
+
+func BenchmarkLog_BigFloat_Fallback(b *testing.B) {
+	x := big.NewFloat(42.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = new(big.Float).SetFloat64(math.Log(xf))
+	}
+}
+
+func BenchmarkLog_Shopspring_Fallback(b *testing.B) {
+	x := shopspring.NewFromFloat(42.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = shopspring.NewFromFloat(math.Log(xf))
+	}
+}
+
+func BenchmarkLog_Govalues_Fallback(b *testing.B) {
+	x, _ := govalues.Parse("42.5")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_, _ = govalues.NewFromFloat64(math.Log(xf))
+	}
+}
+
+func BenchmarkLog_Fixed_Fallback(b *testing.B) {
+	x := fixed.NewF(42.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = fixedLn(x)
+	}
+}
+
+func BenchmarkLog_Alpaca_Fallback(b *testing.B) {
+	x := alpacadecimal.NewFromFloat(42.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = alpacaLn(x)
+	}
+}
+
+func BenchmarkPow_Float64_Native(b *testing.B) {
+	x, y := 1.05, 2.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = math.Pow(x, y)
+	}
+}
+
+func BenchmarkPow_Cockroach_Native(b *testing.B) {
+	x, _, _ := apd.NewFromString("1.05")
+	y, _, _ := apd.NewFromString("2.5")
+	result := apd.New(0, 0)
+	ctx := apd.BaseContext
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.Pow(result, x, y)
+	}
+}
+
+func BenchmarkPow_BigFloat_Fallback(b *testing.B) {
+	x := big.NewFloat(1.05)
+	y := 2.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = new(big.Float).SetFloat64(math.Pow(xf, y))
+	}
+}
+
+func BenchmarkPow_Shopspring_Fallback(b *testing.B) {
+	x := shopspring.NewFromFloat(1.05)
+	y := 2.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_ = shopspring.NewFromFloat(math.Pow(xf, y))
+	}
+}
+
+func BenchmarkPow_Govalues_Fallback(b *testing.B) {
+	x, _ := govalues.Parse("1.05")
+	y := 2.5
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		xf, _ := x.Float64()
+		_, _ = govalues.NewFromFloat64(math.Pow(xf, y))
+	}
+}
+
+// powFixed and powAlpaca compute x^y as exp(y*ln(x)), reusing the same
+// Taylor/Newton primitives as Exp and Log above rather than a separate
+// implementation.
+func powFixed(x, y fixed.Fixed) fixed.Fixed {
+	return fixedExp(y.Mul(fixedLn(x)))
+}
+
+func powAlpaca(x, y alpacadecimal.Decimal) alpacadecimal.Decimal {
+	return alpacaExp(y.Mul(alpacaLn(x)))
+}
+
+func BenchmarkPow_Fixed_Fallback(b *testing.B) {
+	x := fixed.NewF(1.05)
+	y := fixed.NewF(2.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = powFixed(x, y)
+	}
+}
+
+func BenchmarkPow_Alpaca_Fallback(b *testing.B) {
+	x := alpacadecimal.NewFromFloat(1.05)
+	y := alpacadecimal.NewFromFloat(2.5)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = powAlpaca(x, y)
+	}
+}
+
+// =============================================================================
+// Net present value over a 360-period schedule (a 30-year mortgage's worth of
+// monthly cashflows), discounted at a fractional monthly rate. Each period
+// the running discount factor is divided down by (1+rate) rather than raised
+// to a power, since that's how every one of these libraries would actually
+// be used for an amortization schedule — nobody calls Pow 360 times when an
+// iterative Div does the same job with one multiply/divide per period.
+// =============================================================================
+
+const npvPeriods = 360
+
+func npvMonthlyRate() float64 { return 0.055 / 12 }
+
+func npvCashflow(period int) float64 {
+	// A level payment with a small seasonal wobble, just enough to keep the
+	// schedule from being trivially constant.
+	return 1200 + 50*math.Sin(float64(period)/6)
+}
+
+func BenchmarkNPV_Float64(b *testing.B) {
+	rate := npvMonthlyRate()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv := 0.0
+		discount := 1.0
+		for p := 1; p <= npvPeriods; p++ {
+			discount /= 1 + rate
+			npv += npvCashflow(p) * discount
+		}
+		_ = npv
+	}
+}
+
+func BenchmarkNPV_Shopspring(b *testing.B) {
+	rate := shopspring.NewFromFloat(npvMonthlyRate())
+	onePlusRate := shopspring.NewFromInt(1).Add(rate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv := shopspring.Zero
+		discount := shopspring.NewFromInt(1)
+		for p := 1; p <= npvPeriods; p++ {
+			discount = discount.DivRound(onePlusRate, 18)
+			cf := shopspring.NewFromFloat(npvCashflow(p))
+			npv = npv.Add(cf.Mul(discount))
+		}
+		_ = npv
+	}
+}
+
+func BenchmarkNPV_Govalues(b *testing.B) {
+	rate, _ := govalues.NewFromFloat64(npvMonthlyRate())
+	one, _ := govalues.Parse("1")
+	onePlusRate, _ := one.Add(rate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv, _ := govalues.Parse("0")
+		discount := one
+		for p := 1; p <= npvPeriods; p++ {
+			discount, _ = discount.Quo(onePlusRate)
+			cf, _ := govalues.NewFromFloat64(npvCashflow(p))
+			term, _ := cf.Mul(discount)
+			npv, _ = npv.Add(term)
+		}
+		_ = npv
+	}
+}
+
+func BenchmarkNPV_Cockroach(b *testing.B) {
+	rate, _, _ := apd.NewFromString(formatFloat(npvMonthlyRate()))
+	ctx := apd.BaseContext.WithPrecision(30)
+	onePlusRate := apd.New(0, 0)
+	_, _ = ctx.Add(onePlusRate, apd.New(1, 0), rate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv := apd.New(0, 0)
+		discount := apd.New(1, 0)
+		cf := apd.New(0, 0)
+		term := apd.New(0, 0)
+		for p := 1; p <= npvPeriods; p++ {
+			_, _ = ctx.Quo(discount, discount, onePlusRate)
+			_, _, _ = cf.SetString(formatFloat(npvCashflow(p)))
+			_, _ = ctx.Mul(term, cf, discount)
+			_, _ = ctx.Add(npv, npv, term)
+		}
+		_ = npv
+	}
+}
+
+func BenchmarkNPV_Fixed(b *testing.B) {
+	rate := fixed.NewF(npvMonthlyRate())
+	onePlusRate := fixed.NewF(1).Add(rate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv := fixed.NewF(0)
+		discount := fixed.NewF(1)
+		for p := 1; p <= npvPeriods; p++ {
+			discount = discount.Div(onePlusRate)
+			cf := fixed.NewF(npvCashflow(p))
+			npv = npv.Add(cf.Mul(discount))
+		}
+		_ = npv
+	}
+}
+
+func BenchmarkNPV_Alpaca(b *testing.B) {
+	rate := alpacadecimal.NewFromFloat(npvMonthlyRate())
+	onePlusRate := alpacadecimal.NewFromFloat(1).Add(rate)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		npv := alpacadecimal.NewFromFloat(0)
+		discount := alpacadecimal.NewFromFloat(1)
+		for p := 1; p <= npvPeriods; p++ {
+			discount = discount.Div(onePlusRate)
+			cf := alpacadecimal.NewFromFloat(npvCashflow(p))
+			npv = npv.Add(cf.Mul(discount))
+		}
+		_ = npv
+	}
+}
+
+// =============================================================================
+// Black-Scholes call price. None of these libraries carry a native erf, so
+// every variant below parses its inputs in its own decimal type, drops to
+// float64 for the d1/d2/N(x) math via blackScholesCall, and converts the
+// result back — the same thing a real pricing engine does when it keeps
+// ledger amounts decimal but prices off a float64 math core. What's being
+// measured here is each library's parse/conversion overhead around that
+// shared core, not the pricing math itself.
+// =============================================================================
+
+const (
+	bsSpot   = 101.50
+	bsStrike = 100.00
+	bsRate   = 0.03
+	bsSigma  = 0.22
+	bsTenor  = 0.5
+)
+
+func BenchmarkBlackScholes_Float64(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = blackScholesCall(bsSpot, bsStrike, bsRate, bsSigma, bsTenor)
+	}
+}
+
+func BenchmarkBlackScholes_Shopspring(b *testing.B) {
+	S := shopspring.NewFromFloat(bsSpot)
+	K := shopspring.NewFromFloat(bsStrike)
+	r := shopspring.NewFromFloat(bsRate)
+	sigma := shopspring.NewFromFloat(bsSigma)
+	T := shopspring.NewFromFloat(bsTenor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sf, _ := S.Float64()
+		kf, _ := K.Float64()
+		rf, _ := r.Float64()
+		sigmaf, _ := sigma.Float64()
+		tf, _ := T.Float64()
+		_ = shopspring.NewFromFloat(blackScholesCall(sf, kf, rf, sigmaf, tf))
+	}
+}
+
+func BenchmarkBlackScholes_Govalues(b *testing.B) {
+	S, _ := govalues.NewFromFloat64(bsSpot)
+	K, _ := govalues.NewFromFloat64(bsStrike)
+	r, _ := govalues.NewFromFloat64(bsRate)
+	sigma, _ := govalues.NewFromFloat64(bsSigma)
+	T, _ := govalues.NewFromFloat64(bsTenor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sf, _ := S.Float64()
+		kf, _ := K.Float64()
+		rf, _ := r.Float64()
+		sigmaf, _ := sigma.Float64()
+		tf, _ := T.Float64()
+		_, _ = govalues.NewFromFloat64(blackScholesCall(sf, kf, rf, sigmaf, tf))
+	}
+}
+
+func BenchmarkBlackScholes_Cockroach(b *testing.B) {
+	S, _, _ := apd.NewFromString(formatFloat(bsSpot))
+	K, _, _ := apd.NewFromString(formatFloat(bsStrike))
+	r, _, _ := apd.NewFromString(formatFloat(bsRate))
+	sigma, _, _ := apd.NewFromString(formatFloat(bsSigma))
+	T, _, _ := apd.NewFromString(formatFloat(bsTenor))
+	result := apd.New(0, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sf, _ := S.Float64()
+		kf, _ := K.Float64()
+		rf, _ := r.Float64()
+		sigmaf, _ := sigma.Float64()
+		tf, _ := T.Float64()
+		_, _, _ = result.SetString(formatFloat(blackScholesCall(sf, kf, rf, sigmaf, tf)))
+	}
+}
+
+func BenchmarkBlackScholes_Fixed(b *testing.B) {
+	S := fixed.NewF(bsSpot)
+	K := fixed.NewF(bsStrike)
+	r := fixed.NewF(bsRate)
+	sigma := fixed.NewF(bsSigma)
+	T := fixed.NewF(bsTenor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = fixed.NewF(blackScholesCall(S.Float(), K.Float(), r.Float(), sigma.Float(), T.Float()))
+	}
+}
+
+func BenchmarkBlackScholes_Alpaca(b *testing.B) {
+	S := alpacadecimal.NewFromFloat(bsSpot)
+	K := alpacadecimal.NewFromFloat(bsStrike)
+	r := alpacadecimal.NewFromFloat(bsRate)
+	sigma := alpacadecimal.NewFromFloat(bsSigma)
+	T := alpacadecimal.NewFromFloat(bsTenor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = alpacadecimal.NewFromFloat(blackScholesCall(S.Float64(), K.Float64(), r.Float64(), sigma.Float64(), T.Float64()))
+	}
+}
@@ -0,0 +1,234 @@
+// Package decimaljsonbench measures the cost of serializing decimal-valued
+// structs to and from JSON, the question the decimalbench and jsonbench
+// suites each answer half of on their own: an Invoice field's decimal
+// library choice changes not just arithmetic cost but how it shows up on
+// the wire.
+package decimaljsonbench
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/apd/v3"
+	govalues "github.com/govalues/decimal"
+	shopspring "github.com/shopspring/decimal"
+)
+
+const (
+	numInvoices    = 1000
+	invoiceTaxRate = 0.0825
+)
+
+// rawLineItem is the library-agnostic seed every per-library Invoice variant
+// below is built from, so all four benchmarks price the exact same 1000
+// invoices.
+type rawLineItem struct {
+	Price float64
+	Qty   float64
+}
+
+type rawInvoice struct {
+	LineItems []rawLineItem
+}
+
+// generateRawInvoices produces a deterministic mix of 1-10 line item
+// invoices.
+func generateRawInvoices(n int) []rawInvoice {
+	invoices := make([]rawInvoice, n)
+	for i := range invoices {
+		itemCount := 1 + i%10
+		items := make([]rawLineItem, itemCount)
+		for j := range items {
+			items[j] = rawLineItem{
+				Price: 9.99 + float64(j)*1.5,
+				Qty:   float64(1 + (i+j)%5),
+			}
+		}
+		invoices[i] = rawInvoice{LineItems: items}
+	}
+	return invoices
+}
+
+var rawInvoices = generateRawInvoices(numInvoices)
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// ---------------------------------------------------------------------------
+// float64
+// ---------------------------------------------------------------------------
+
+type Float64LineItem struct {
+	Price  float64 `json:"price"`
+	Qty    float64 `json:"qty"`
+	Amount float64 `json:"amount"`
+}
+
+type Float64Invoice struct {
+	Subtotal  float64           `json:"subtotal"`
+	Tax       float64           `json:"tax"`
+	Total     float64           `json:"total"`
+	LineItems []Float64LineItem `json:"line_items"`
+}
+
+func buildFloat64Invoices(raw []rawInvoice) []Float64Invoice {
+	invoices := make([]Float64Invoice, len(raw))
+	for i, r := range raw {
+		items := make([]Float64LineItem, len(r.LineItems))
+		var subtotal float64
+		for j, li := range r.LineItems {
+			amount := li.Price * li.Qty
+			items[j] = Float64LineItem{Price: li.Price, Qty: li.Qty, Amount: amount}
+			subtotal += amount
+		}
+		tax := subtotal * invoiceTaxRate
+		invoices[i] = Float64Invoice{Subtotal: subtotal, Tax: tax, Total: subtotal + tax, LineItems: items}
+	}
+	return invoices
+}
+
+// ---------------------------------------------------------------------------
+// shopspring: Decimal's MarshalJSON emits a quoted string by default, or a
+// bare number when the package-level MarshalJSONWithoutQuotes is set.
+// ---------------------------------------------------------------------------
+
+type ShopLineItem struct {
+	Price  shopspring.Decimal `json:"price"`
+	Qty    shopspring.Decimal `json:"qty"`
+	Amount shopspring.Decimal `json:"amount"`
+}
+
+type ShopInvoice struct {
+	Subtotal  shopspring.Decimal `json:"subtotal"`
+	Tax       shopspring.Decimal `json:"tax"`
+	Total     shopspring.Decimal `json:"total"`
+	LineItems []ShopLineItem     `json:"line_items"`
+}
+
+func buildShopInvoices(raw []rawInvoice) []ShopInvoice {
+	taxRate := shopspring.NewFromFloat(invoiceTaxRate)
+	invoices := make([]ShopInvoice, len(raw))
+	for i, r := range raw {
+		items := make([]ShopLineItem, len(r.LineItems))
+		subtotal := shopspring.Zero
+		for j, li := range r.LineItems {
+			price := shopspring.NewFromFloat(li.Price)
+			qty := shopspring.NewFromFloat(li.Qty)
+			amount := price.Mul(qty)
+			items[j] = ShopLineItem{Price: price, Qty: qty, Amount: amount}
+			subtotal = subtotal.Add(amount)
+		}
+		tax := subtotal.Mul(taxRate).Round(2)
+		invoices[i] = ShopInvoice{Subtotal: subtotal, Tax: tax, Total: subtotal.Add(tax), LineItems: items}
+	}
+	return invoices
+}
+
+// ---------------------------------------------------------------------------
+// govalues
+// ---------------------------------------------------------------------------
+
+type GovLineItem struct {
+	Price  govalues.Decimal `json:"price"`
+	Qty    govalues.Decimal `json:"qty"`
+	Amount govalues.Decimal `json:"amount"`
+}
+
+type GovInvoice struct {
+	Subtotal  govalues.Decimal `json:"subtotal"`
+	Tax       govalues.Decimal `json:"tax"`
+	Total     govalues.Decimal `json:"total"`
+	LineItems []GovLineItem    `json:"line_items"`
+}
+
+func buildGovInvoices(raw []rawInvoice) []GovInvoice {
+	taxRate, _ := govalues.NewFromFloat64(invoiceTaxRate)
+	invoices := make([]GovInvoice, len(raw))
+	for i, r := range raw {
+		items := make([]GovLineItem, len(r.LineItems))
+		subtotal, _ := govalues.Parse("0")
+		for j, li := range r.LineItems {
+			price, _ := govalues.NewFromFloat64(li.Price)
+			qty, _ := govalues.NewFromFloat64(li.Qty)
+			amount, _ := price.Mul(qty)
+			items[j] = GovLineItem{Price: price, Qty: qty, Amount: amount}
+			subtotal, _ = subtotal.Add(amount)
+		}
+		tax, _ := subtotal.Mul(taxRate)
+		tax = tax.Round(2)
+		total, _ := subtotal.Add(tax)
+		invoices[i] = GovInvoice{Subtotal: subtotal, Tax: tax, Total: total, LineItems: items}
+	}
+	return invoices
+}
+
+// ---------------------------------------------------------------------------
+// apd: apd.Decimal has no MarshalJSON of its own, so apdMoney wraps it in
+// the quoted-string representation a real API would ship.
+// ---------------------------------------------------------------------------
+
+var invoiceCtx = apd.BaseContext.WithPrecision(30)
+
+type apdMoney apd.Decimal
+
+func (m apdMoney) MarshalJSON() ([]byte, error) {
+	d := apd.Decimal(m)
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+func (m *apdMoney) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return err
+	}
+	*m = apdMoney(*d)
+	return nil
+}
+
+type ApdLineItem struct {
+	Price  apdMoney `json:"price"`
+	Qty    apdMoney `json:"qty"`
+	Amount apdMoney `json:"amount"`
+}
+
+type ApdInvoice struct {
+	Subtotal  apdMoney      `json:"subtotal"`
+	Tax       apdMoney      `json:"tax"`
+	Total     apdMoney      `json:"total"`
+	LineItems []ApdLineItem `json:"line_items"`
+}
+
+func buildApdInvoices(raw []rawInvoice) []ApdInvoice {
+	taxRate, _, _ := apd.NewFromString(formatFloat(invoiceTaxRate))
+	invoices := make([]ApdInvoice, len(raw))
+	for i, r := range raw {
+		items := make([]ApdLineItem, len(r.LineItems))
+		subtotal := apd.New(0, 0)
+		for j, li := range r.LineItems {
+			price, _, _ := apd.NewFromString(formatFloat(li.Price))
+			qty, _, _ := apd.NewFromString(formatFloat(li.Qty))
+			amount := apd.New(0, 0)
+			_, _ = invoiceCtx.Mul(amount, price, qty)
+			items[j] = ApdLineItem{Price: apdMoney(*price), Qty: apdMoney(*qty), Amount: apdMoney(*amount)}
+			_, _ = invoiceCtx.Add(subtotal, subtotal, amount)
+		}
+		tax := apd.New(0, 0)
+		_, _ = invoiceCtx.Mul(tax, subtotal, taxRate)
+		_, _ = invoiceCtx.Quantize(tax, tax, -2)
+		total := apd.New(0, 0)
+		_, _ = invoiceCtx.Add(total, subtotal, tax)
+		invoices[i] = ApdInvoice{Subtotal: apdMoney(*subtotal), Tax: apdMoney(*tax), Total: apdMoney(*total), LineItems: items}
+	}
+	return invoices
+}
+
+var (
+	float64Invoices = buildFloat64Invoices(rawInvoices)
+	shopInvoices    = buildShopInvoices(rawInvoices)
+	govInvoices     = buildGovInvoices(rawInvoices)
+	apdInvoices     = buildApdInvoices(rawInvoices)
+)
@@ -0,0 +1,166 @@
+package decimaljsonbench
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	shopspring "github.com/shopspring/decimal"
+)
+
+// BenchmarkInvoiceEncode_* marshal all 1000 invoices at once, one call per
+// b.N iteration, against both encoding/json and sonic as the fast-encoder
+// comparison point.
+
+func BenchmarkInvoiceEncode_Float64_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(float64Invoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Float64_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = sonic.Marshal(float64Invoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Shopspring_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(shopInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Shopspring_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = sonic.Marshal(shopInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Govalues_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(govInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Govalues_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = sonic.Marshal(govInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Apd_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(apdInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Apd_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = sonic.Marshal(apdInvoices)
+	}
+}
+
+// BenchmarkInvoiceDecode_* unmarshal the same payloads, marshaled once up
+// front so only the decode cost is measured.
+
+var (
+	float64InvoicesJSON, _ = json.Marshal(float64Invoices)
+	shopInvoicesJSON, _    = json.Marshal(shopInvoices)
+	govInvoicesJSON, _     = json.Marshal(govInvoices)
+	apdInvoicesJSON, _     = json.Marshal(apdInvoices)
+)
+
+func BenchmarkInvoiceDecode_Float64_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []Float64Invoice
+		_ = json.Unmarshal(float64InvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Float64_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []Float64Invoice
+		_ = sonic.Unmarshal(float64InvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Shopspring_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []ShopInvoice
+		_ = json.Unmarshal(shopInvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Shopspring_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []ShopInvoice
+		_ = sonic.Unmarshal(shopInvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Govalues_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []GovInvoice
+		_ = json.Unmarshal(govInvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Govalues_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []GovInvoice
+		_ = sonic.Unmarshal(govInvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Apd_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []ApdInvoice
+		_ = json.Unmarshal(apdInvoicesJSON, &out)
+	}
+}
+
+func BenchmarkInvoiceDecode_Apd_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var out []ApdInvoice
+		_ = sonic.Unmarshal(apdInvoicesJSON, &out)
+	}
+}
+
+// BenchmarkInvoiceEncode_Shopspring_StringVsNumber isolates the cost of
+// shopspring's two JSON representations for the same values: the default
+// quoted-string form (the only one that round-trips exactly through a JS or
+// Python client's own number type) versus the package-level opt-in to emit
+// bare numbers instead.
+
+func BenchmarkInvoiceEncode_Shopspring_AsString(b *testing.B) {
+	shopspring.MarshalJSONWithoutQuotes = false
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(shopInvoices)
+	}
+}
+
+func BenchmarkInvoiceEncode_Shopspring_AsNumber(b *testing.B) {
+	shopspring.MarshalJSONWithoutQuotes = true
+	defer func() { shopspring.MarshalJSONWithoutQuotes = false }()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(shopInvoices)
+	}
+}
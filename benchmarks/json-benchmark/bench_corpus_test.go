@@ -0,0 +1,303 @@
+package jsonbench
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gojson "github.com/goccy/go-json"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/minio/simdjson-go"
+	segmentio "github.com/segmentio/encoding/json"
+)
+
+// codeJSON is the decompressed testdata/code.json.gz fixture, loaded once in
+// TestMain so every benchmark below pays the gunzip cost exactly once rather
+// than per b.N iteration.
+var (
+	codeJSON   []byte
+	codeStruct codeResponse
+)
+
+func TestMain(m *testing.M) {
+	f, err := os.Open("testdata/code.json.gz")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		panic(err)
+	}
+	codeJSON, err = io.ReadAll(gz)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(codeJSON, &codeStruct); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// ============================================================================
+// Whole-document Marshal, reported in MB/s via b.SetBytes
+// ============================================================================
+
+func BenchmarkCode_Marshal_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Marshal_Sonic(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = sonic.Marshal(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Marshal_GoJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = gojson.Marshal(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Marshal_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ji.Marshal(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Marshal_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = segmentio.Marshal(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Marshal_EasyJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = codeStruct.MarshalJSON()
+	}
+}
+
+// ============================================================================
+// Whole-document Unmarshal, reported in MB/s via b.SetBytes
+// ============================================================================
+
+func BenchmarkCode_Unmarshal_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = json.Unmarshal(codeJSON, &r)
+	}
+}
+
+func BenchmarkCode_Unmarshal_Sonic(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = sonic.Unmarshal(codeJSON, &r)
+	}
+}
+
+func BenchmarkCode_Unmarshal_GoJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = gojson.Unmarshal(codeJSON, &r)
+	}
+}
+
+func BenchmarkCode_Unmarshal_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = ji.Unmarshal(codeJSON, &r)
+	}
+}
+
+func BenchmarkCode_Unmarshal_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = segmentio.Unmarshal(codeJSON, &r)
+	}
+}
+
+func BenchmarkCode_Unmarshal_EasyJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		_ = r.UnmarshalJSON(codeJSON)
+	}
+}
+
+// ============================================================================
+// Streaming / token-level decode
+// ============================================================================
+
+// BenchmarkCode_Decoder_Token_Stdlib walks the document token-by-token
+// instead of materializing it into a struct, surfacing the per-token
+// overhead encoding/json's streaming API hides behind Unmarshal.
+func BenchmarkCode_Decoder_Token_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := json.NewDecoder(bytes.NewReader(codeJSON))
+		for {
+			if _, err := dec.Token(); err != nil {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkCode_Decoder_Token_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		it := jsoniter.Parse(ji, bytes.NewReader(codeJSON), 4096)
+		for it.WhatIsNext() != jsoniter.InvalidValue && it.Error == nil {
+			it.Skip()
+		}
+	}
+}
+
+func BenchmarkCode_Decoder_Token_Sonic(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := sonic.ConfigDefault.NewDecoder(bytes.NewReader(codeJSON))
+		for {
+			var tok interface{}
+			if err := dec.Decode(&tok); err != nil {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkCode_Decoder_Token_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := segmentio.NewDecoder(bytes.NewReader(codeJSON))
+		for {
+			if _, err := dec.Token(); err != nil {
+				break
+			}
+		}
+	}
+}
+
+// BenchmarkCode_Decoder_Token_SimdJson walks the parsed document via
+// simdjson-go's iterator instead of decoding into Go values at all, which is
+// the fairest comparison for a format that doesn't expose a token stream.
+func BenchmarkCode_Decoder_Token_SimdJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	var pj *simdjson.ParsedJson
+	for i := 0; i < b.N; i++ {
+		var err error
+		pj, err = simdjson.Parse(codeJSON, pj)
+		if err != nil {
+			b.Fatal(err)
+		}
+		walkSimdjson(pj.Iter())
+	}
+}
+
+// walkSimdjson recursively advances iter over every element, forcing
+// simdjson-go to materialize the same tree shape the struct-based
+// benchmarks decode, without allocating Go structs for it.
+func walkSimdjson(iter simdjson.Iter) {
+	for {
+		typ := iter.Advance()
+		switch typ {
+		case simdjson.TypeNone:
+			return
+		case simdjson.TypeObject:
+			obj, err := iter.Object(nil)
+			if err != nil {
+				return
+			}
+			var elem simdjson.Element
+			for {
+				e, err := obj.NextElement(&elem)
+				if err != nil || e == nil {
+					break
+				}
+				walkSimdjson(elem.Iter)
+			}
+		case simdjson.TypeArray:
+			arr, err := iter.Array(nil)
+			if err != nil {
+				return
+			}
+			walkSimdjson(arr.Iter())
+		}
+	}
+}
+
+// ============================================================================
+// Streaming encode into a discard io.Writer
+// ============================================================================
+
+func BenchmarkCode_Encoder_Stream_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = json.NewEncoder(io.Discard).Encode(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Encoder_Stream_GoJson(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = gojson.NewEncoder(io.Discard).Encode(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Encoder_Stream_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ji.NewEncoder(io.Discard).Encode(&codeStruct)
+	}
+}
+
+func BenchmarkCode_Encoder_Stream_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(codeJSON)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = segmentio.NewEncoder(io.Discard).Encode(&codeStruct)
+	}
+}
@@ -0,0 +1,168 @@
+package jsonbench
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gojson "github.com/goccy/go-json"
+	jsoniter "github.com/json-iterator/go"
+	segmentio "github.com/segmentio/encoding/json"
+)
+
+// ============================================================================
+// Streaming encode of an NDJSON batch (one event per line), reported in MB/s
+// via b.SetBytes. Unlike BenchmarkCode_Encoder_Stream_*, which re-encodes a
+// single object b.N times, each iteration here encodes the full 10k-event
+// batch, since that's the unit a streaming ingestion pipeline actually works
+// in one line at a time.
+// ============================================================================
+
+func BenchmarkEventStream_Encode_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		enc := json.NewEncoder(io.Discard)
+		for j := range streamEvents {
+			_ = enc.Encode(&streamEvents[j])
+		}
+	}
+}
+
+func BenchmarkEventStream_Encode_GoJson(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		enc := gojson.NewEncoder(io.Discard)
+		for j := range streamEvents {
+			_ = enc.Encode(&streamEvents[j])
+		}
+	}
+}
+
+func BenchmarkEventStream_Encode_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		enc := ji.NewEncoder(io.Discard)
+		for j := range streamEvents {
+			_ = enc.Encode(&streamEvents[j])
+		}
+	}
+}
+
+func BenchmarkEventStream_Encode_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		enc := segmentio.NewEncoder(io.Discard)
+		for j := range streamEvents {
+			_ = enc.Encode(&streamEvents[j])
+		}
+	}
+}
+
+// ============================================================================
+// Streaming decode of the same NDJSON batch, one Unmarshal call per line.
+// ============================================================================
+
+func BenchmarkEventStream_Decode_Stdlib(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scanner := bufio.NewScanner(bytes.NewReader(streamEventsRaw))
+		for scanner.Scan() {
+			var e event
+			_ = json.Unmarshal(scanner.Bytes(), &e)
+		}
+	}
+}
+
+func BenchmarkEventStream_Decode_Sonic(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scanner := bufio.NewScanner(bytes.NewReader(streamEventsRaw))
+		for scanner.Scan() {
+			var e event
+			_ = sonic.Unmarshal(scanner.Bytes(), &e)
+		}
+	}
+}
+
+func BenchmarkEventStream_Decode_GoJson(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scanner := bufio.NewScanner(bytes.NewReader(streamEventsRaw))
+		for scanner.Scan() {
+			var e event
+			_ = gojson.Unmarshal(scanner.Bytes(), &e)
+		}
+	}
+}
+
+func BenchmarkEventStream_Decode_Jsoniter(b *testing.B) {
+	ji := jsoniter.ConfigCompatibleWithStandardLibrary
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scanner := bufio.NewScanner(bytes.NewReader(streamEventsRaw))
+		for scanner.Scan() {
+			var e event
+			_ = ji.Unmarshal(scanner.Bytes(), &e)
+		}
+	}
+}
+
+func BenchmarkEventStream_Decode_Segmentio(b *testing.B) {
+	b.SetBytes(int64(len(streamEventsRaw)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		scanner := bufio.NewScanner(bytes.NewReader(streamEventsRaw))
+		for scanner.Scan() {
+			var e event
+			_ = segmentio.Unmarshal(scanner.Bytes(), &e)
+		}
+	}
+}
+
+// ============================================================================
+// Partial parse: pull client_id/event_name out of bigEvent (5000 line items)
+// without paying to decode the items array. Stdlib's Unmarshal still has to
+// scan past every byte of the payload to validate it even though eventHeader
+// only declares two fields, so it's the baseline this is meant to beat.
+// Sonic and jsoniter both expose a lazy path-based Get that can stop as soon
+// as it finds the requested key instead of decoding the whole document.
+// ============================================================================
+
+func BenchmarkEventPartialParse_Stdlib(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var h eventHeader
+		_ = json.Unmarshal(bigEventJSON, &h)
+	}
+}
+
+func BenchmarkEventPartialParse_Sonic(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		clientNode, _ := sonic.Get(bigEventJSON, "client_id")
+		_, _ = clientNode.String()
+		nameNode, _ := sonic.Get(bigEventJSON, "event_name")
+		_, _ = nameNode.String()
+	}
+}
+
+func BenchmarkEventPartialParse_Jsoniter(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		any := jsoniter.Get(bigEventJSON)
+		_ = any.Get("client_id").ToString()
+		_ = any.Get("event_name").ToString()
+	}
+}
@@ -0,0 +1,22 @@
+package jsonbench
+
+// codeResponse and node mirror the shape of the classic encoding/json
+// code.json.gz benchmark fixture (a recursive package dependency tree),
+// which testdata/code.json.gz was generated to match.
+//
+//easyjson:json
+type codeResponse struct {
+	Tree     *node
+	Username string
+}
+
+//easyjson:json
+type node struct {
+	Name     string
+	Kids     []*node
+	CLWeight float64
+	Touches  int
+	MinT     int64
+	MaxT     int64
+	MeanT    int64
+}
@@ -0,0 +1,99 @@
+package jsonbench
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// eventItem is one line item inside an event, modeled on the GA4
+// Measurement Protocol's "items" array.
+type eventItem struct {
+	ItemID   string  `json:"item_id"`
+	ItemName string  `json:"item_name"`
+	Price    float64 `json:"price"`
+	Quantity int     `json:"quantity"`
+}
+
+//easyjson:json
+type event struct {
+	ClientID  string      `json:"client_id"`
+	EventName string      `json:"event_name"`
+	Timestamp int64       `json:"timestamp"`
+	Items     []eventItem `json:"items"`
+}
+
+const numStreamEvents = 10000
+
+// generateEvents produces a deterministic sequence of events, each carrying
+// between 5 and 50 line items, mirroring an analytics pipeline's mix of
+// single-item and large-cart purchase events.
+func generateEvents(n int) []event {
+	events := make([]event, n)
+	for i := 0; i < n; i++ {
+		itemCount := 5 + i%46
+		items := make([]eventItem, itemCount)
+		for j := range items {
+			items[j] = eventItem{
+				ItemID:   "sku_" + strconv.Itoa(j),
+				ItemName: "Item " + strconv.Itoa(j),
+				Price:    9.99 + float64(j%20),
+				Quantity: 1 + j%5,
+			}
+		}
+		events[i] = event{
+			ClientID:  fmt.Sprintf("client_%d", i),
+			EventName: "purchase",
+			Timestamp: int64(1700000000 + i),
+			Items:     items,
+		}
+	}
+	return events
+}
+
+// buildNDJSON newline-delimits one JSON object per event, the shape an
+// analytics ingestion endpoint actually receives a batch of events in.
+func buildNDJSON(events []event) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i := range events {
+		_ = enc.Encode(&events[i])
+	}
+	return buf.Bytes()
+}
+
+var (
+	streamEvents    = generateEvents(numStreamEvents)
+	streamEventsRaw = buildNDJSON(streamEvents)
+)
+
+// eventHeader is the subset of event a consumer might want without paying
+// to decode every line item, used by the partial-parse benchmarks below.
+type eventHeader struct {
+	ClientID  string `json:"client_id"`
+	EventName string `json:"event_name"`
+}
+
+// bigEvent is a single event with enough line items that extracting just
+// ClientID/EventName from it should meaningfully undercut decoding the
+// whole thing, which is the point the partial-parse benchmarks are making.
+var bigEvent = func() event {
+	items := make([]eventItem, 5000)
+	for j := range items {
+		items[j] = eventItem{
+			ItemID:   "sku_" + strconv.Itoa(j),
+			ItemName: "Item " + strconv.Itoa(j),
+			Price:    9.99 + float64(j%20),
+			Quantity: 1 + j%5,
+		}
+	}
+	return event{
+		ClientID:  "client_big",
+		EventName: "purchase",
+		Timestamp: 1700000000,
+		Items:     items,
+	}
+}()
+
+var bigEventJSON, _ = json.Marshal(&bigEvent)
@@ -0,0 +1,99 @@
+/**
+ * Lock-Free Stack Benchmark
+ *
+ * Compares a mutex-protected stack, the GC-reclaimed lock-free stack, and
+ * the hazard-pointer stack with pooled nodes across goroutine counts,
+ * mirroring the multi-goroutine push/pop harness from lock_free_stack.go's
+ * main().
+ *
+ * Run: go test -bench=. -benchmem
+ */
+package lockfreebench
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"lockfree"
+)
+
+var goroutineCounts = []int{1, 2, 8, 64}
+
+type mutexStack struct {
+	mu    sync.Mutex
+	items []int
+}
+
+func (s *mutexStack) Push(v int) {
+	s.mu.Lock()
+	s.items = append(s.items, v)
+	s.mu.Unlock()
+}
+
+func (s *mutexStack) Pop() (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items) == 0 {
+		return 0, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// runPushPop splits b.N push+pop pairs evenly across goroutines goroutines,
+// each doing its share of pushes followed by its share of pops.
+func runPushPop(b *testing.B, goroutines int, push func(int), pop func() (int, bool)) {
+	b.Helper()
+	var wg sync.WaitGroup
+	perGoroutine := b.N / goroutines
+	if perGoroutine == 0 {
+		perGoroutine = 1
+	}
+
+	b.ResetTimer()
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				push(id*perGoroutine + i)
+			}
+			for i := 0; i < perGoroutine; i++ {
+				pop()
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkMutexStack(b *testing.B) {
+	for _, n := range goroutineCounts {
+		b.Run(fmt.Sprintf("goroutines=%d", n), func(b *testing.B) {
+			s := &mutexStack{}
+			b.ReportAllocs()
+			runPushPop(b, n, s.Push, s.Pop)
+		})
+	}
+}
+
+func BenchmarkGCStack(b *testing.B) {
+	for _, n := range goroutineCounts {
+		b.Run(fmt.Sprintf("goroutines=%d", n), func(b *testing.B) {
+			s := lockfree.NewStack[int]()
+			b.ReportAllocs()
+			runPushPop(b, n, s.Push, s.Pop)
+		})
+	}
+}
+
+func BenchmarkHazardPooledStack(b *testing.B) {
+	for _, n := range goroutineCounts {
+		b.Run(fmt.Sprintf("goroutines=%d", n), func(b *testing.B) {
+			s := lockfree.NewPooledHazardStack[int](n)
+			b.ReportAllocs()
+			runPushPop(b, n, s.Push, s.Pop)
+		})
+	}
+}
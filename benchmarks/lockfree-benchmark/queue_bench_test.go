@@ -0,0 +1,147 @@
+/**
+ * Lock-Free Queue Benchmark
+ *
+ * Compares a mutex-protected queue, a buffered channel, the Michael-Scott
+ * lock-free queue, and (SPSC only) the ring buffer from the lockfree package
+ * across producer:consumer ratios.
+ *
+ * Run: go test -bench=. -benchmem
+ */
+package lockfreebench
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"lockfree"
+)
+
+// queueRatios covers 1:1, N:1, and N:N producer:consumer splits.
+var queueRatios = []struct {
+	producers int
+	consumers int
+}{
+	{producers: 1, consumers: 1},
+	{producers: 4, consumers: 1},
+	{producers: 4, consumers: 4},
+}
+
+type mutexQueue struct {
+	mu    sync.Mutex
+	items []int
+}
+
+func (q *mutexQueue) Enqueue(v int) {
+	q.mu.Lock()
+	q.items = append(q.items, v)
+	q.mu.Unlock()
+}
+
+func (q *mutexQueue) TryDequeue() (int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) == 0 {
+		return 0, false
+	}
+	v := q.items[0]
+	q.items = q.items[1:]
+	return v, true
+}
+
+// runProducerConsumer splits b.N items across producers goroutines calling
+// enqueue, and drains them with consumers goroutines polling dequeue until
+// every item has been consumed. dequeue is expected to be non-blocking
+// (returning false rather than waiting), matching how Queue.Dequeue,
+// RingBuffer.Pop, and mutexQueue.TryDequeue all behave.
+func runProducerConsumer(b *testing.B, producers, consumers int, enqueue func(int), dequeue func() (int, bool)) {
+	b.Helper()
+	total := int64(b.N)
+	var produced, consumed int64
+	var wg sync.WaitGroup
+
+	b.ResetTimer()
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := atomic.AddInt64(&produced, 1) - 1
+				if i >= total {
+					return
+				}
+				enqueue(int(i))
+			}
+		}()
+	}
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt64(&consumed) < total {
+				if _, ok := dequeue(); ok {
+					atomic.AddInt64(&consumed, 1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkMutexQueue(b *testing.B) {
+	for _, r := range queueRatios {
+		b.Run(fmt.Sprintf("producers=%d,consumers=%d", r.producers, r.consumers), func(b *testing.B) {
+			q := &mutexQueue{}
+			b.ReportAllocs()
+			runProducerConsumer(b, r.producers, r.consumers, q.Enqueue, q.TryDequeue)
+		})
+	}
+}
+
+func BenchmarkChanQueue(b *testing.B) {
+	for _, r := range queueRatios {
+		b.Run(fmt.Sprintf("producers=%d,consumers=%d", r.producers, r.consumers), func(b *testing.B) {
+			// Sized generously so the channel's own backpressure doesn't
+			// become the bottleneck being measured.
+			ch := make(chan int, 1<<16)
+			enqueue := func(v int) { ch <- v }
+			dequeue := func() (int, bool) {
+				select {
+				case v := <-ch:
+					return v, true
+				default:
+					return 0, false
+				}
+			}
+			b.ReportAllocs()
+			runProducerConsumer(b, r.producers, r.consumers, enqueue, dequeue)
+		})
+	}
+}
+
+func BenchmarkMSQueue(b *testing.B) {
+	for _, r := range queueRatios {
+		b.Run(fmt.Sprintf("producers=%d,consumers=%d", r.producers, r.consumers), func(b *testing.B) {
+			q := lockfree.NewQueue[int]()
+			b.ReportAllocs()
+			runProducerConsumer(b, r.producers, r.consumers, q.Enqueue, q.Dequeue)
+		})
+	}
+}
+
+// BenchmarkRingBuffer only runs the 1:1 ratio: RingBuffer is documented SPSC,
+// and a second producer or consumer would race on the same head/tail index.
+func BenchmarkRingBuffer(b *testing.B) {
+	rb := lockfree.NewRingBuffer[int](1 << 12)
+	enqueue := func(v int) {
+		for !rb.Push(v) {
+			runtime.Gosched() // buffer full; wait for the consumer to drain it
+		}
+	}
+	b.ReportAllocs()
+	runProducerConsumer(b, 1, 1, enqueue, rb.Pop)
+}
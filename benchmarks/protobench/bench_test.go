@@ -0,0 +1,432 @@
+package protobench
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+
+	gen "protobench/gen"
+	gengogo "protobench/gengogo"
+)
+
+// Test data, generated once and shared across benchmarks the same way
+// jsonbench's simpleData/nestedData/complexData are.
+var (
+	simpleData  = generateSimple(100)
+	nestedData  = generateNested(100)
+	complexData = generateComplex(50)
+
+	simpleMsgpack, _  = msgpack.Marshal(simpleData)
+	nestedMsgpack, _  = msgpack.Marshal(nestedData)
+	complexMsgpack, _ = msgpack.Marshal(complexData)
+
+	simpleCBOR, _  = cbor.Marshal(simpleData)
+	nestedCBOR, _  = cbor.Marshal(nestedData)
+	complexCBOR, _ = cbor.Marshal(complexData)
+
+	// Batches wrap the whole simpleData/nestedData/complexData slice in a
+	// single repeated-field message, so proto.Marshal serializes the same
+	// 100 (or 50) records as one call, matching what msgpack.Marshal and
+	// cbor.Marshal do above. Marshaling just simpleData[0] would compare a
+	// single-record payload against a 100-record one.
+	simplePB  = toProtoSimpleBatch(simpleData)
+	nestedPB  = toProtoNestedBatch(nestedData)
+	complexPB = toProtoComplexBatch(complexData)
+
+	simplePBBytes, _  = proto.Marshal(simplePB)
+	nestedPBBytes, _  = proto.Marshal(nestedPB)
+	complexPBBytes, _ = proto.Marshal(complexPB)
+
+	gogoSimple  = toGogoSimpleBatch(simpleData)
+	gogoNested  = toGogoNestedBatch(nestedData)
+	gogoComplex = toGogoComplexBatch(complexData)
+
+	gogoSimpleBytes, _  = gogoSimple.Marshal()
+	gogoNestedBytes, _  = gogoNested.Marshal()
+	gogoComplexBytes, _ = gogoComplex.Marshal()
+)
+
+func toProtoSimple(s Simple) *gen.Simple {
+	return &gen.Simple{Id: s.ID, Name: s.Name, Age: s.Age}
+}
+
+func toProtoNested(n Nested) *gen.Nested {
+	return &gen.Nested{
+		Id:              n.ID,
+		Name:            n.Name,
+		Email:           n.Email,
+		Active:          n.Active,
+		Score:           n.Score,
+		Tags:            n.Tags,
+		Meta:            n.Meta,
+		CreatedUnixNano: n.Created.UnixNano(),
+	}
+}
+
+func toProtoComplex(c Complex) *gen.Complex {
+	friends := make([]*gen.Nested, len(c.Friends))
+	for i, f := range c.Friends {
+		friends[i] = toProtoNested(f)
+	}
+	return &gen.Complex{
+		User:    toProtoNested(c.User),
+		Friends: friends,
+		Settings: &gen.Settings{
+			Theme:       c.Settings.Theme,
+			Language:    c.Settings.Language,
+			Preferences: c.Settings.Preferences,
+		},
+		Metadata: c.Metadata,
+	}
+}
+
+func toProtoSimpleBatch(data []Simple) *gen.SimpleBatch {
+	items := make([]*gen.Simple, len(data))
+	for i, s := range data {
+		items[i] = toProtoSimple(s)
+	}
+	return &gen.SimpleBatch{Items: items}
+}
+
+func toProtoNestedBatch(data []Nested) *gen.NestedBatch {
+	items := make([]*gen.Nested, len(data))
+	for i, n := range data {
+		items[i] = toProtoNested(n)
+	}
+	return &gen.NestedBatch{Items: items}
+}
+
+func toProtoComplexBatch(data []Complex) *gen.ComplexBatch {
+	items := make([]*gen.Complex, len(data))
+	for i, c := range data {
+		items[i] = toProtoComplex(c)
+	}
+	return &gen.ComplexBatch{Items: items}
+}
+
+func toGogoSimple(s Simple) *gengogo.Simple {
+	return &gengogo.Simple{Id: s.ID, Name: s.Name, Age: s.Age}
+}
+
+func toGogoNested(n Nested) *gengogo.Nested {
+	return &gengogo.Nested{
+		Id:              n.ID,
+		Name:            n.Name,
+		Email:           n.Email,
+		Active:          n.Active,
+		Score:           n.Score,
+		Tags:            n.Tags,
+		Meta:            n.Meta,
+		CreatedUnixNano: n.Created.UnixNano(),
+	}
+}
+
+func toGogoComplex(c Complex) *gengogo.Complex {
+	friends := make([]*gengogo.Nested, len(c.Friends))
+	for i, f := range c.Friends {
+		friends[i] = toGogoNested(f)
+	}
+	return &gengogo.Complex{
+		User:    toGogoNested(c.User),
+		Friends: friends,
+		Settings: &gengogo.Settings{
+			Theme:       c.Settings.Theme,
+			Language:    c.Settings.Language,
+			Preferences: c.Settings.Preferences,
+		},
+		Metadata: c.Metadata,
+	}
+}
+
+func toGogoSimpleBatch(data []Simple) *gengogo.SimpleBatch {
+	items := make([]*gengogo.Simple, len(data))
+	for i, s := range data {
+		items[i] = toGogoSimple(s)
+	}
+	return &gengogo.SimpleBatch{Items: items}
+}
+
+func toGogoNestedBatch(data []Nested) *gengogo.NestedBatch {
+	items := make([]*gengogo.Nested, len(data))
+	for i, n := range data {
+		items[i] = toGogoNested(n)
+	}
+	return &gengogo.NestedBatch{Items: items}
+}
+
+func toGogoComplexBatch(data []Complex) *gengogo.ComplexBatch {
+	items := make([]*gengogo.Complex, len(data))
+	for i, c := range data {
+		items[i] = toGogoComplex(c)
+	}
+	return &gengogo.ComplexBatch{Items: items}
+}
+
+// ============================================================================
+// Simple tier
+// ============================================================================
+
+func BenchmarkSimple_Marshal_Msgpack(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simpleMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_, _ = msgpack.Marshal(simpleData)
+	}
+}
+
+func BenchmarkSimple_Unmarshal_Msgpack(b *testing.B) {
+	var out []Simple
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simpleMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_ = msgpack.Unmarshal(simpleMsgpack, &out)
+	}
+}
+
+func BenchmarkSimple_Marshal_CBOR(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simpleCBOR)))
+	for i := 0; i < b.N; i++ {
+		_, _ = cbor.Marshal(simpleData)
+	}
+}
+
+func BenchmarkSimple_Unmarshal_CBOR(b *testing.B) {
+	var out []Simple
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simpleCBOR)))
+	for i := 0; i < b.N; i++ {
+		_ = cbor.Unmarshal(simpleCBOR, &out)
+	}
+}
+
+func BenchmarkSimple_Marshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simplePBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = proto.Marshal(simplePB)
+	}
+}
+
+func BenchmarkSimple_Unmarshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simplePBBytes)))
+	for i := 0; i < b.N; i++ {
+		var out gen.SimpleBatch
+		_ = proto.Unmarshal(simplePBBytes, &out)
+	}
+}
+
+func BenchmarkSimple_Marshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simplePBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = simplePB.MarshalVT()
+	}
+}
+
+func BenchmarkSimple_Unmarshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(simplePBBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gen.SimpleBatch)
+		_ = out.UnmarshalVT(simplePBBytes)
+	}
+}
+
+func BenchmarkSimple_Marshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoSimpleBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = gogoSimple.Marshal()
+	}
+}
+
+func BenchmarkSimple_Unmarshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoSimpleBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gengogo.SimpleBatch)
+		_ = out.Unmarshal(gogoSimpleBytes)
+	}
+}
+
+// ============================================================================
+// Nested tier
+// ============================================================================
+
+func BenchmarkNested_Marshal_Msgpack(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_, _ = msgpack.Marshal(nestedData)
+	}
+}
+
+func BenchmarkNested_Unmarshal_Msgpack(b *testing.B) {
+	var out []Nested
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_ = msgpack.Unmarshal(nestedMsgpack, &out)
+	}
+}
+
+func BenchmarkNested_Marshal_CBOR(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedCBOR)))
+	for i := 0; i < b.N; i++ {
+		_, _ = cbor.Marshal(nestedData)
+	}
+}
+
+func BenchmarkNested_Unmarshal_CBOR(b *testing.B) {
+	var out []Nested
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedCBOR)))
+	for i := 0; i < b.N; i++ {
+		_ = cbor.Unmarshal(nestedCBOR, &out)
+	}
+}
+
+func BenchmarkNested_Marshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedPBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = proto.Marshal(nestedPB)
+	}
+}
+
+func BenchmarkNested_Unmarshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedPBBytes)))
+	for i := 0; i < b.N; i++ {
+		var out gen.NestedBatch
+		_ = proto.Unmarshal(nestedPBBytes, &out)
+	}
+}
+
+func BenchmarkNested_Marshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedPBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = nestedPB.MarshalVT()
+	}
+}
+
+func BenchmarkNested_Unmarshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(nestedPBBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gen.NestedBatch)
+		_ = out.UnmarshalVT(nestedPBBytes)
+	}
+}
+
+func BenchmarkNested_Marshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoNestedBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = gogoNested.Marshal()
+	}
+}
+
+func BenchmarkNested_Unmarshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoNestedBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gengogo.NestedBatch)
+		_ = out.Unmarshal(gogoNestedBytes)
+	}
+}
+
+// ============================================================================
+// Complex tier
+// ============================================================================
+
+func BenchmarkComplex_Marshal_Msgpack(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_, _ = msgpack.Marshal(complexData)
+	}
+}
+
+func BenchmarkComplex_Unmarshal_Msgpack(b *testing.B) {
+	var out []Complex
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexMsgpack)))
+	for i := 0; i < b.N; i++ {
+		_ = msgpack.Unmarshal(complexMsgpack, &out)
+	}
+}
+
+func BenchmarkComplex_Marshal_CBOR(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexCBOR)))
+	for i := 0; i < b.N; i++ {
+		_, _ = cbor.Marshal(complexData)
+	}
+}
+
+func BenchmarkComplex_Unmarshal_CBOR(b *testing.B) {
+	var out []Complex
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexCBOR)))
+	for i := 0; i < b.N; i++ {
+		_ = cbor.Unmarshal(complexCBOR, &out)
+	}
+}
+
+func BenchmarkComplex_Marshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexPBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = proto.Marshal(complexPB)
+	}
+}
+
+func BenchmarkComplex_Unmarshal_Protobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexPBBytes)))
+	for i := 0; i < b.N; i++ {
+		var out gen.ComplexBatch
+		_ = proto.Unmarshal(complexPBBytes, &out)
+	}
+}
+
+func BenchmarkComplex_Marshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexPBBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = complexPB.MarshalVT()
+	}
+}
+
+func BenchmarkComplex_Unmarshal_VTProtobuf(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(complexPBBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gen.ComplexBatch)
+		_ = out.UnmarshalVT(complexPBBytes)
+	}
+}
+
+func BenchmarkComplex_Marshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoComplexBytes)))
+	for i := 0; i < b.N; i++ {
+		_, _ = gogoComplex.Marshal()
+	}
+}
+
+func BenchmarkComplex_Unmarshal_Gogo(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(gogoComplexBytes)))
+	for i := 0; i < b.N; i++ {
+		out := new(gengogo.ComplexBatch)
+		_ = out.Unmarshal(gogoComplexBytes)
+	}
+}
@@ -0,0 +1,107 @@
+/**
+ * render ingests `go test -bench=. -benchmem` output and emits a Markdown
+ * table grouped by (payload size x operation x format), so a single bench
+ * run produces a JSON-vs-binary-format comparison doc.
+ *
+ * Run: go test -bench=. -benchmem ./... | go run ./cmd/render
+ */
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// benchLine matches a single `go test -bench` result row, e.g.:
+//
+//	BenchmarkSimple_Marshal_Msgpack-8   1234567   123.4 ns/op   48 B/op   1 allocs/op
+var benchLine = regexp.MustCompile(
+	`^Benchmark(\w+)-\d+\s+\d+\s+([\d.]+)\s+ns/op(?:\s+([\d.]+)\s+B/op)?(?:\s+([\d.]+)\s+allocs/op)?`,
+)
+
+type result struct {
+	Size, Operation, Format string
+	NsPerOp, BytesPerOp     float64
+	AllocsPerOp             float64
+}
+
+func main() {
+	results, err := parse(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "render:", err)
+		os.Exit(1)
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(os.Stderr, "render: no benchmark lines found on stdin")
+		os.Exit(1)
+	}
+	render(os.Stdout, results)
+}
+
+// parse reads `go test -bench` output and extracts one result per
+// BenchmarkSize_Operation_Format line. Lines that don't match (compiler
+// output, PASS/ok summary lines, etc.) are ignored.
+func parse(r io.Reader) ([]result, error) {
+	var out []result
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := benchLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		parts := strings.SplitN(m[1], "_", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		res := result{Size: parts[0], Operation: parts[1], Format: parts[2]}
+		res.NsPerOp, _ = strconv.ParseFloat(m[2], 64)
+		if m[3] != "" {
+			res.BytesPerOp, _ = strconv.ParseFloat(m[3], 64)
+		}
+		if m[4] != "" {
+			res.AllocsPerOp, _ = strconv.ParseFloat(m[4], 64)
+		}
+		out = append(out, res)
+	}
+	return out, scanner.Err()
+}
+
+// render groups results by (size, operation) and writes one Markdown table
+// per group, ordered fastest-to-slowest by ns/op within the group.
+func render(w io.Writer, results []result) {
+	type key struct{ size, op string }
+	groups := map[key][]result{}
+	var order []key
+	for _, r := range results {
+		k := key{r.Size, r.Operation}
+		if _, seen := groups[k]; !seen {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], r)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if order[i].size != order[j].size {
+			return order[i].size < order[j].size
+		}
+		return order[i].op < order[j].op
+	})
+
+	for _, k := range order {
+		rows := groups[k]
+		sort.Slice(rows, func(i, j int) bool { return rows[i].NsPerOp < rows[j].NsPerOp })
+
+		fmt.Fprintf(w, "### %s / %s\n\n", k.size, k.op)
+		fmt.Fprintln(w, "| Format | ns/op | B/op | allocs/op |")
+		fmt.Fprintln(w, "|---|---:|---:|---:|")
+		for _, r := range rows {
+			fmt.Fprintf(w, "| %s | %.1f | %.0f | %.0f |\n", r.Format, r.NsPerOp, r.BytesPerOp, r.AllocsPerOp)
+		}
+		fmt.Fprintln(w)
+	}
+}
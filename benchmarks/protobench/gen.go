@@ -0,0 +1,31 @@
+package protobench
+
+// Regenerating the wire-format bindings requires protoc plus the three Go
+// plugins below on $PATH:
+//
+//	go install google.golang.org/protobuf/cmd/protoc-gen-go@latest
+//	go install github.com/gogo/protobuf/protoc-gen-gogofaster@latest
+//	go install github.com/planetscale/vtprotobuf/cmd/protoc-gen-go-vtproto@latest
+//
+// google.golang.org/protobuf and vtprotobuf share the same generated types
+// (vtproto just adds MarshalVT/UnmarshalVT methods alongside the stdlib
+// Marshal/Unmarshal ones), so both write into ./gen from messages.proto,
+// whose own `go_package` is protobench/gen.
+//
+// gogo/protobuf produces its own incompatible message types and gets its
+// own package, ./gengogo, generated from messages_gogo.proto instead of
+// messages.proto. protoc-gen-gogo derives a generated file's package name
+// directly from that file's own go_package option — an -M flag only
+// overrides the import path other files see when importing it, not its own
+// declared package name — so there's no flag that makes messages.proto
+// generate into protobench/gengogo under `package gengogo`. messages_gogo.proto
+// is messages.proto's message definitions with go_package set to
+// protobench/gengogo instead, kept in sync by hand.
+//
+// The generated output of all three steps is checked in below rather than
+// gitignored, since CI and this sandbox don't have protoc or the plugins
+// installed.
+
+//go:generate protoc --go_out=./gen --go_opt=paths=source_relative messages.proto
+//go:generate protoc --go-vtproto_out=./gen --go-vtproto_opt=paths=source_relative --go-vtproto_opt=features=marshal+unmarshal+size messages.proto
+//go:generate protoc --gogofaster_out=./gengogo --gogofaster_opt=paths=source_relative messages_gogo.proto
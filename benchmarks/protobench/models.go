@@ -0,0 +1,82 @@
+package protobench
+
+import "time"
+
+// Simple, Nested, and Complex are the msgpack/CBOR analogues of jsonbench's
+// SimpleStruct/NestedStruct/ComplexStruct: same field shapes, tagged for
+// both github.com/vmihailenco/msgpack/v5 and github.com/fxamacker/cbor/v2 so
+// one struct set serves both binary encoders, the same way the json tag
+// alone serves all of jsonbench's encoders.
+type Simple struct {
+	ID   int64  `msgpack:"id" cbor:"id"`
+	Name string `msgpack:"name" cbor:"name"`
+	Age  int64  `msgpack:"age" cbor:"age"`
+}
+
+type Nested struct {
+	ID      int64             `msgpack:"id" cbor:"id"`
+	Name    string            `msgpack:"name" cbor:"name"`
+	Email   string            `msgpack:"email" cbor:"email"`
+	Active  bool              `msgpack:"active" cbor:"active"`
+	Score   float64           `msgpack:"score" cbor:"score"`
+	Tags    []string          `msgpack:"tags" cbor:"tags"`
+	Meta    map[string]string `msgpack:"meta" cbor:"meta"`
+	Created time.Time         `msgpack:"created" cbor:"created"`
+}
+
+type Complex struct {
+	User     Nested   `msgpack:"user" cbor:"user"`
+	Friends  []Nested `msgpack:"friends" cbor:"friends"`
+	Settings struct {
+		Theme       string            `msgpack:"theme" cbor:"theme"`
+		Language    string            `msgpack:"language" cbor:"language"`
+		Preferences map[string]string `msgpack:"preferences" cbor:"preferences"`
+	} `msgpack:"settings" cbor:"settings"`
+	Metadata map[string]string `msgpack:"metadata" cbor:"metadata"`
+}
+
+func generateSimple(n int) []Simple {
+	data := make([]Simple, n)
+	for i := 0; i < n; i++ {
+		data[i] = Simple{ID: int64(i), Name: "User", Age: int64(20 + i%50)}
+	}
+	return data
+}
+
+func generateNested(n int) []Nested {
+	data := make([]Nested, n)
+	now := time.Now()
+	for i := 0; i < n; i++ {
+		data[i] = Nested{
+			ID:      int64(i),
+			Name:    "User",
+			Email:   "user@example.com",
+			Active:  i%2 == 0,
+			Score:   float64(i) * 1.5,
+			Tags:    []string{"tag1", "tag2", "tag3"},
+			Meta:    map[string]string{"key1": "value1", "key2": "value2"},
+			Created: now,
+		}
+	}
+	return data
+}
+
+func generateComplex(n int) []Complex {
+	data := make([]Complex, n)
+	for i := 0; i < n; i++ {
+		friends := make([]Nested, 5)
+		for j := 0; j < 5; j++ {
+			friends[j] = Nested{ID: int64(j), Name: "Friend", Tags: []string{"friend", "social"}}
+		}
+		c := Complex{
+			User:     Nested{ID: int64(i), Name: "User", Tags: []string{"vip", "premium"}},
+			Friends:  friends,
+			Metadata: map[string]string{"version": "1.0", "source": "benchmark"},
+		}
+		c.Settings.Theme = "dark"
+		c.Settings.Language = "en"
+		c.Settings.Preferences = map[string]string{"notifications": "on"}
+		data[i] = c
+	}
+	return data
+}
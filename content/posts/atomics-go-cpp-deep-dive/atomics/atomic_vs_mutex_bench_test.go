@@ -7,6 +7,7 @@
 package atomics
 
 import (
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -234,3 +235,63 @@ func BenchmarkWithPadding_Separate(b *testing.B) {
 		}
 	})
 }
+
+// =============================================================================
+// Sharded counters
+//
+// BenchmarkAtomicAdd_Parallel above puts every goroutine's increments on one
+// cache line, so throughput flattens out as GOMAXPROCS grows no matter how
+// cheap a single atomic add is. Spreading the counter across
+// runtime.GOMAXPROCS(0) cache-line-padded shards (CachePadded in
+// cacheline.go) removes that line from being shared at all; each goroutine
+// below claims its shard once via the same "assign an id, then loop" pattern
+// BenchmarkWithPadding_Separate uses above, rather than anything
+// runtime-internal like P-pinning. Run with -cpu=1,2,4,8,16 to see the
+// scaling curves diverge from the single-counter benchmarks.
+// =============================================================================
+
+func BenchmarkShardedCounter_Parallel(b *testing.B) {
+	counter := newShardedCounter(runtime.GOMAXPROCS(0))
+	var nextShard int64
+	b.RunParallel(func(pb *testing.PB) {
+		shard := int(atomic.AddInt64(&nextShard, 1) - 1)
+		for pb.Next() {
+			counter.add(shard, 1)
+		}
+	})
+}
+
+// =============================================================================
+// Arena-style per-goroutine accumulation
+//
+// Each worker accumulates into a private counter drawn from a sync.Pool
+// (so the slice of them is reused across benchmark iterations rather than
+// allocated fresh) and only folds it into the shared atomic total once it
+// crosses arenaFlushThreshold. This trades a small amount of staleness in
+// the running total for far fewer cross-goroutine atomic operations than
+// BenchmarkAtomicAdd_Parallel performs.
+// =============================================================================
+
+var arenaPool = sync.Pool{New: func() any { return new(int64) }}
+
+const arenaFlushThreshold = 256
+
+func BenchmarkArenaPooledCounter_Parallel(b *testing.B) {
+	var global int64
+	b.RunParallel(func(pb *testing.PB) {
+		local := arenaPool.Get().(*int64)
+		*local = 0
+		for pb.Next() {
+			*local++
+			if *local >= arenaFlushThreshold {
+				atomic.AddInt64(&global, *local)
+				*local = 0
+			}
+		}
+		if *local > 0 {
+			atomic.AddInt64(&global, *local)
+			*local = 0
+		}
+		arenaPool.Put(local)
+	})
+}
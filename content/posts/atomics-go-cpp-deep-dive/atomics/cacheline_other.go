@@ -0,0 +1,5 @@
+//go:build !arm64
+
+package atomics
+
+const cacheLineSize = 64
@@ -0,0 +1,126 @@
+package lockfree
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// hazardRecord is one reusable hazard slot. A goroutine claims a record with
+// acquire, publishes the node it's about to dereference into slot, and
+// releases the record once it's done with the node. Both fields are read by
+// other goroutines during reclaim/acquire without any shared lock, so both
+// have to be atomic.
+type hazardRecord[T any] struct {
+	active atomic.Bool
+	slot   atomic.Pointer[node[T]]
+}
+
+func (r *hazardRecord[T]) protect(n *node[T]) {
+	r.slot.Store(n)
+}
+
+func (r *hazardRecord[T]) release() {
+	r.slot.Store(nil)
+	r.active.Store(false)
+}
+
+// hazardDomain owns the hazard records and retired list for a single Stack.
+// Reclamation threshold is the classic 2*H, where H is the number of hazard
+// records: once the retired list crosses that size, the domain scans every
+// record and frees whatever isn't currently hazarded.
+type hazardDomain[T any] struct {
+	records []*hazardRecord[T]
+
+	mu      sync.Mutex // guards retired only; record claiming is lock-free
+	retired []*node[T]
+	pool    *nodePool[T] // nil: retired nodes are simply dropped for the GC
+}
+
+func newHazardDomain[T any](maxGoroutines int, pool *nodePool[T]) *hazardDomain[T] {
+	d := &hazardDomain[T]{
+		records: make([]*hazardRecord[T], maxGoroutines),
+		pool:    pool,
+	}
+	for i := range d.records {
+		d.records[i] = &hazardRecord[T]{}
+	}
+	return d
+}
+
+// acquire finds a free hazard record for the calling goroutine via CAS, so
+// it never blocks on the mutex that guards reclamation.
+func (d *hazardDomain[T]) acquire() *hazardRecord[T] {
+	for {
+		for _, r := range d.records {
+			if r.active.CompareAndSwap(false, true) {
+				return r
+			}
+		}
+		runtime.Gosched() // all slots busy; exceptionally rare, just yield and retry
+	}
+}
+
+// retire queues n for reclamation once it's no longer hazarded, and runs a
+// reclamation pass once the retired list has grown past the 2*H threshold.
+func (d *hazardDomain[T]) retire(n *node[T]) {
+	d.mu.Lock()
+	d.retired = append(d.retired, n)
+	var batch []*node[T]
+	if len(d.retired) >= 2*len(d.records) {
+		batch, d.retired = d.retired, nil
+	}
+	d.mu.Unlock()
+
+	if batch != nil {
+		d.reclaim(batch)
+	}
+}
+
+func (d *hazardDomain[T]) reclaim(batch []*node[T]) {
+	d.mu.Lock()
+	hazarded := make(map[*node[T]]struct{}, len(d.records))
+	for _, r := range d.records {
+		if p := r.slot.Load(); p != nil {
+			hazarded[p] = struct{}{}
+		}
+	}
+	var stillBusy []*node[T]
+	for _, n := range batch {
+		if _, busy := hazarded[n]; busy {
+			stillBusy = append(stillBusy, n)
+			continue
+		}
+		if d.pool != nil {
+			d.pool.put(n)
+		}
+		// No pool: n is unreachable from any hazard record or the stack
+		// itself, so it's simply left for ordinary GC to collect.
+	}
+	d.retired = append(d.retired, stillBusy...)
+	d.mu.Unlock()
+}
+
+// nodePool is a thin sync.Pool wrapper typed to node[T], so Push/Pop in
+// pooled-hazard mode does no heap allocation once warmed up.
+type nodePool[T any] struct {
+	pool sync.Pool
+}
+
+func newNodePool[T any]() *nodePool[T] {
+	return &nodePool[T]{
+		pool: sync.Pool{New: func() any { return new(node[T]) }},
+	}
+}
+
+func (p *nodePool[T]) get() *node[T] {
+	n := p.pool.Get().(*node[T])
+	n.next.Store(nil)
+	return n
+}
+
+func (p *nodePool[T]) put(n *node[T]) {
+	var zero T
+	n.value = zero
+	p.pool.Put(n)
+}
@@ -0,0 +1,165 @@
+package lockfree
+
+import "sync/atomic"
+
+// =============================================================================
+// Queue: an unbounded, generic Michael-Scott lock-free MPMC queue
+// =============================================================================
+
+type qnode[T any] struct {
+	value T
+	next  atomic.Pointer[qnode[T]]
+}
+
+// Queue is an unbounded, multi-producer multi-consumer lock-free queue,
+// reclaimed by the GC. Unlike Stack it has no hazard-pointer mode: Dequeue
+// only ever touches the node it's about to remove and the dummy node behind
+// it, so the classic Michael-Scott "help move a lagging tail" trick below is
+// enough without a separate reclamation scheme.
+type Queue[T any] struct {
+	head atomic.Pointer[qnode[T]]
+	tail atomic.Pointer[qnode[T]]
+	size atomic.Int64
+}
+
+// NewQueue returns an empty Queue.
+func NewQueue[T any]() *Queue[T] {
+	dummy := &qnode[T]{}
+	q := &Queue[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds value to the back of the queue.
+func (q *Queue[T]) Enqueue(value T) {
+	n := &qnode[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue // tail moved since we read it; retry
+		}
+		if next == nil {
+			if tail.next.CompareAndSwap(nil, n) {
+				// Swing tail to the node we just linked. If this CAS fails,
+				// another goroutine already helped us move it; either way
+				// the enqueue itself is done.
+				q.tail.CompareAndSwap(tail, n)
+				q.size.Add(1)
+				return
+			}
+			continue
+		}
+		// tail is lagging one node behind the real end; help it catch up
+		// before retrying our own link.
+		q.tail.CompareAndSwap(tail, next)
+	}
+}
+
+// Dequeue removes and returns the value at the front of the queue, or the
+// zero value and false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue // head moved since we read it; retry
+		}
+		if head == tail {
+			if next == nil {
+				var zero T
+				return zero, false
+			}
+			// tail is lagging behind the real end; help it catch up before
+			// retrying.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return value, true
+		}
+	}
+}
+
+// Size returns the current number of elements.
+func (q *Queue[T]) Size() int64 {
+	return q.size.Load()
+}
+
+// Len is Size, exposed under the name the rest of the package's collections
+// use.
+func (q *Queue[T]) Len() int {
+	return int(q.size.Load())
+}
+
+// =============================================================================
+// RingBuffer: a bounded, single-producer single-consumer ring buffer
+// =============================================================================
+
+// paddedCounter rounds an atomic.Uint64 up to a full cache line, the same
+// trick CountersPadded in the atomics benchmark uses by hand: head and tail
+// are written by different goroutines, so without the padding they'd share
+// a line and false-share on every Push/Pop.
+type paddedCounter struct {
+	v atomic.Uint64
+	_ [56]byte
+}
+
+// RingBuffer is a bounded ring buffer safe for exactly one producer calling
+// Push and exactly one consumer calling Pop concurrently (SPSC). Because
+// each side owns its index outright, neither Push nor Pop needs a CAS loop
+// the way Queue does.
+type RingBuffer[T any] struct {
+	buf  []T
+	mask uint64
+
+	head paddedCounter // producer-owned: next slot to write
+	tail paddedCounter // consumer-owned: next slot to read
+}
+
+// NewRingBuffer returns a RingBuffer with room for at least capacity
+// elements, rounded up to the next power of two since slot selection uses a
+// bitmask rather than a modulo.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	size := 1
+	for size < capacity {
+		size <<= 1
+	}
+	return &RingBuffer[T]{buf: make([]T, size), mask: uint64(size - 1)}
+}
+
+// Push appends v, returning false if the buffer is full. Only the single
+// producer goroutine may call Push.
+func (r *RingBuffer[T]) Push(v T) bool {
+	head := r.head.v.Load()
+	tail := r.tail.v.Load()
+	if head-tail == uint64(len(r.buf)) {
+		return false
+	}
+	r.buf[head&r.mask] = v
+	r.head.v.Store(head + 1)
+	return true
+}
+
+// Pop removes and returns the oldest element, or the zero value and false if
+// the buffer is empty. Only the single consumer goroutine may call Pop.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	tail := r.tail.v.Load()
+	head := r.head.v.Load()
+	if tail == head {
+		var zero T
+		return zero, false
+	}
+	v := r.buf[tail&r.mask]
+	var zero T
+	r.buf[tail&r.mask] = zero // drop the reference so Pop doesn't keep it alive
+	r.tail.v.Store(tail + 1)
+	return v, true
+}
@@ -0,0 +1,146 @@
+/**
+ * Package lockfree is the reusable version of the Treiber stack from the
+ * "Atomics in Go" post: a generic Stack[T] built on atomic.Pointer, with an
+ * optional hazard-pointer mode (see hazard.go) for workloads that can't
+ * afford to leave reclamation to the GC.
+ */
+package lockfree
+
+import "sync/atomic"
+
+type node[T any] struct {
+	value T
+	next  atomic.Pointer[node[T]]
+}
+
+// Stack is a lock-free, generic Treiber stack. The zero value is not usable;
+// construct one with NewStack, NewHazardStack, or NewPooledHazardStack.
+type Stack[T any] struct {
+	head   atomic.Pointer[node[T]]
+	size   atomic.Int64
+	domain *hazardDomain[T] // nil: popped nodes are left for the GC
+}
+
+// NewStack returns a Stack that reclaims popped nodes via ordinary Go
+// garbage collection, same as the original demo in lock_free_stack.go.
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// NewHazardStack returns a Stack that reclaims popped nodes explicitly
+// through hazard pointers instead of relying on the GC. maxGoroutines bounds
+// how many concurrent Pop calls can hold a hazard slot at once; a Pop from a
+// goroutine beyond that bound blocks until a slot frees up.
+func NewHazardStack[T any](maxGoroutines int) *Stack[T] {
+	return &Stack[T]{domain: newHazardDomain[T](maxGoroutines, nil)}
+}
+
+// NewPooledHazardStack is NewHazardStack plus a sync.Pool backing node
+// allocation: reclaimed nodes are returned to the pool instead of dropped,
+// so steady-state Push/Pop does no heap allocation at all.
+func NewPooledHazardStack[T any](maxGoroutines int) *Stack[T] {
+	pool := newNodePool[T]()
+	return &Stack[T]{domain: newHazardDomain[T](maxGoroutines, pool)}
+}
+
+// Push adds value to the top of the stack.
+func (s *Stack[T]) Push(value T) {
+	n := s.newNode(value)
+	for {
+		oldHead := s.head.Load()
+		n.next.Store(oldHead)
+		if s.head.CompareAndSwap(oldHead, n) {
+			s.size.Add(1)
+			return
+		}
+		// CAS failed, another goroutine modified head; retry
+	}
+}
+
+func (s *Stack[T]) newNode(value T) *node[T] {
+	if s.domain != nil && s.domain.pool != nil {
+		n := s.domain.pool.get()
+		n.value = value
+		return n
+	}
+	return &node[T]{value: value}
+}
+
+// Pop removes and returns the top value, or the zero value and false if the
+// stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	if s.domain != nil {
+		return s.popHazard()
+	}
+	return s.popGC()
+}
+
+func (s *Stack[T]) popGC() (T, bool) {
+	for {
+		oldHead := s.head.Load()
+		if oldHead == nil {
+			var zero T
+			return zero, false
+		}
+		newHead := oldHead.next.Load()
+		if s.head.CompareAndSwap(oldHead, newHead) {
+			s.size.Add(-1)
+			return oldHead.value, true
+		}
+		// CAS failed, another goroutine modified head; retry
+	}
+}
+
+func (s *Stack[T]) popHazard() (T, bool) {
+	rec := s.domain.acquire()
+	defer rec.release()
+
+	for {
+		oldHead := s.head.Load()
+		if oldHead == nil {
+			var zero T
+			return zero, false
+		}
+		rec.protect(oldHead)
+		if s.head.Load() != oldHead {
+			continue // head moved before the hazard was published; retry
+		}
+		newHead := oldHead.next.Load()
+		if s.head.CompareAndSwap(oldHead, newHead) {
+			s.size.Add(-1)
+			value := oldHead.value
+			s.domain.retire(oldHead)
+			return value, true
+		}
+		// CAS failed, another goroutine modified head; retry
+	}
+}
+
+// Size returns the current number of elements.
+func (s *Stack[T]) Size() int64 {
+	return s.size.Load()
+}
+
+// Len is Size, exposed under the name the rest of the package's collections
+// use.
+func (s *Stack[T]) Len() int {
+	return int(s.size.Load())
+}
+
+func (s *Stack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
+
+// Range calls fn for every element from top to bottom, stopping early if fn
+// returns false. In GC-reclaimed mode this is safe to call concurrently with
+// Push/Pop. In hazard-pointer mode a concurrent Pop may retire (and, with a
+// pool, reuse) a node Range is still visiting, so callers using
+// NewHazardStack/NewPooledHazardStack must synchronize Range against Pop
+// themselves.
+func (s *Stack[T]) Range(fn func(T) bool) {
+	for n := s.head.Load(); n != nil; n = n.next.Load() {
+		if !fn(n.value) {
+			return
+		}
+	}
+}